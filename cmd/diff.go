@@ -0,0 +1,56 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/WillemCode/AliCloud_Resources/internal/api"
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+)
+
+// runDiff 执行 diff 子命令，对比两个日期的资源快照并按指定格式输出
+// 用法: diff -from 2024-01-01 [-to 2024-01-31] [-format markdown|csv] [-account 账户] [-region 区域] [-type 资源类型]
+func runDiff(args []string, w io.Writer) error {
+	fs := flag.NewFlagSet("diff", flag.ContinueOnError)
+	from := fs.String("from", "", "起始日期（YYYY-MM-DD）")
+	to := fs.String("to", time.Now().Format("2006-01-02"), "结束日期（YYYY-MM-DD），默认今天")
+	format := fs.String("format", "markdown", "输出格式：markdown 或 csv")
+	account := fs.String("account", "", "按账户筛选")
+	region := fs.String("region", "", "按区域筛选")
+	resourceType := fs.String("type", "", "按资源类型筛选")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *from == "" {
+		return fmt.Errorf("缺少 -from 参数")
+	}
+	fromDate, err := time.Parse("2006-01-02", *from)
+	if err != nil {
+		return fmt.Errorf("-from 日期格式错误，应为 YYYY-MM-DD: %s", *from)
+	}
+	toDate, err := time.Parse("2006-01-02", *to)
+	if err != nil {
+		return fmt.Errorf("-to 日期格式错误，应为 YYYY-MM-DD: %s", *to)
+	}
+	if fromDate.After(toDate) {
+		return fmt.Errorf("-from 不能晚于 -to (%s > %s)", *from, *to)
+	}
+	var write func(io.Writer, database.ResourceDiff) error
+	switch *format {
+	case "markdown":
+		write = api.WriteDiffMarkdown
+	case "csv":
+		write = api.WriteDiffCSV
+	default:
+		return fmt.Errorf("不支持的输出格式: %s", *format)
+	}
+
+	filter := database.StatsFilter{CloudName: *account, RegionID: *region, ResourceType: *resourceType}
+	diff, err := database.DiffResourceSnapshots(filter, *from, *to)
+	if err != nil {
+		return err
+	}
+	return write(w, diff)
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"os"
+	"time"
 
 	"github.com/WillemCode/AliCloud_Resources/internal/api"
 	"github.com/WillemCode/AliCloud_Resources/internal/services"
@@ -32,6 +33,17 @@ func main() {
 	}
 	defer database.Close() // 程序退出时关闭数据库
 
+	// diff 子命令：对比已保存的资源快照后直接退出，不执行同步
+	if len(os.Args) > 1 && os.Args[1] == "diff" {
+		if err := runDiff(os.Args[2:], os.Stdout); err != nil {
+			logger.Log.Errorf("资源变更对比失败: %v", err)
+			// os.Exit 不会执行 defer，先关闭数据库再以非零状态退出
+			database.Close()
+			os.Exit(1)
+		}
+		return
+	}
+
 	// // 5. 遍历配置中的每个阿里云账户，调用相应服务同步数据
 	for _, account := range cfg.AliyunAccounts {
 
@@ -41,6 +53,10 @@ func main() {
 				// 记录错误但不中断，继续处理其他服务
 				logger.Log.Errorf("ECS 同步失败 (账户=%s): %v", account.Name, err)
 			}
+			// 同步安全组及规则（与 ECS 使用相同的区域）
+			if err := services.SyncSecurityGroupInfo(account.Name, account.ECSRegionIds, account.AccessKey, account.AccessSecret); err != nil {
+				logger.Log.Errorf("安全组 同步失败 (账户=%s): %v", account.Name, err)
+			}
 		}
 		// 同步 RDS 信息
 		if len(account.RDSRegionIds) > 0 {
@@ -55,6 +71,17 @@ func main() {
 			if err := services.SyncSLBInfo(account.Name, account.SLBRegionIds, account.AccessKey, account.AccessSecret); err != nil {
 				logger.Log.Errorf("SLB 同步失败 (账户=%s): %v", account.Name, err)
 			}
+			// ALB/NLB 与 CLB 使用相同的区域配置
+			if err := services.SyncALBInfo(account.Name, account.SLBRegionIds, account.AccessKey, account.AccessSecret); err != nil {
+				logger.Log.Errorf("ALB 同步失败 (账户=%s): %v", account.Name, err)
+			}
+			if err := services.SyncNLBInfo(account.Name, account.SLBRegionIds, account.AccessKey, account.AccessSecret); err != nil {
+				logger.Log.Errorf("NLB 同步失败 (账户=%s): %v", account.Name, err)
+			}
+			// CLB 上传的服务器证书
+			if err := services.SyncSLBCertificateInfo(account.Name, account.SLBRegionIds, account.AccessKey, account.AccessSecret); err != nil {
+				logger.Log.Errorf("CLB 证书同步失败 (账户=%s): %v", account.Name, err)
+			}
 		}
 		// 同步 Tair Redis 信息
 		if len(account.RedisRegionIds) > 0 {
@@ -70,6 +97,165 @@ func main() {
 				logger.Log.Errorf("PolarDB 同步失败 (账户=%s): %v", account.Name, err)
 			}
 		}
+		// 同步 MongoDB 信息
+		if len(account.MongoDBRegionIds) > 0 {
+			if err := services.SyncMongoDBInfo(account.Name, account.MongoDBRegionIds, account.AccessKey, account.AccessSecret); err != nil {
+				logger.Log.Errorf("MongoDB 同步失败 (账户=%s): %v", account.Name, err)
+			}
+		}
+		// 同步消息队列信息（Kafka、RocketMQ、RabbitMQ 使用相同的区域配置）
+		if len(account.MQRegionIds) > 0 {
+			if err := services.SyncKafkaInfo(account.Name, account.MQRegionIds, account.AccessKey, account.AccessSecret); err != nil {
+				logger.Log.Errorf("Kafka 同步失败 (账户=%s): %v", account.Name, err)
+			}
+			if err := services.SyncRocketMQInfo(account.Name, account.MQRegionIds, account.AccessKey, account.AccessSecret); err != nil {
+				logger.Log.Errorf("RocketMQ 同步失败 (账户=%s): %v", account.Name, err)
+			}
+			if err := services.SyncAMQPInfo(account.Name, account.MQRegionIds, account.AccessKey, account.AccessSecret); err != nil {
+				logger.Log.Errorf("RabbitMQ 同步失败 (账户=%s): %v", account.Name, err)
+			}
+		}
+		// 同步 ACK 集群、节点池及节点归属
+		if len(account.ACKRegionIds) > 0 {
+			if err := services.SyncACKInfo(account.Name, account.ACKRegionIds, account.AccessKey, account.AccessSecret); err != nil {
+				logger.Log.Errorf("ACK 同步失败 (账户=%s): %v", account.Name, err)
+			}
+		}
+		// 同步 OSS Bucket 信息
+		if len(account.OSSRegionIds) > 0 {
+			if err := services.SyncOSSInfo(account.Name, account.OSSRegionIds, account.AccessKey, account.AccessSecret); err != nil {
+				logger.Log.Errorf("OSS 同步失败 (账户=%s): %v", account.Name, err)
+			}
+		}
+		// 同步云解析 DNS 及 PrivateZone 解析记录
+		if len(account.DNSRegionIds) > 0 {
+			if err := services.SyncDNSInfo(account.Name, account.DNSRegionIds, account.AccessKey, account.AccessSecret); err != nil {
+				logger.Log.Errorf("DNS 同步失败 (账户=%s): %v", account.Name, err)
+			}
+			if err := services.SyncPrivateZoneInfo(account.Name, account.DNSRegionIds, account.AccessKey, account.AccessSecret); err != nil {
+				logger.Log.Errorf("PrivateZone 同步失败 (账户=%s): %v", account.Name, err)
+			}
+		}
+		// 同步数字证书管理服务中的证书
+		if len(account.CASRegionIds) > 0 {
+			if err := services.SyncCASInfo(account.Name, account.CASRegionIds, account.AccessKey, account.AccessSecret); err != nil {
+				logger.Log.Errorf("CAS 同步失败 (账户=%s): %v", account.Name, err)
+			}
+		}
+		// 同步 RAM 用户、用户组、角色及 AccessKey
+		if len(account.RAMRegionIds) > 0 {
+			if err := services.SyncRAMInfo(account.Name, account.RAMRegionIds, account.AccessKey, account.AccessSecret); err != nil {
+				logger.Log.Errorf("RAM 同步失败 (账户=%s): %v", account.Name, err)
+			}
+		}
+		// 同步 CDN/DCDN 加速域名及源站
+		if len(account.CDNRegionIds) > 0 {
+			if err := services.SyncCDNInfo(account.Name, account.CDNRegionIds, account.AccessKey, account.AccessSecret); err != nil {
+				logger.Log.Errorf("CDN 同步失败 (账户=%s): %v", account.Name, err)
+			}
+			if err := services.SyncDCDNInfo(account.Name, account.CDNRegionIds, account.AccessKey, account.AccessSecret); err != nil {
+				logger.Log.Errorf("DCDN 同步失败 (账户=%s): %v", account.Name, err)
+			}
+		}
+		// 同步弹性伸缩组及组内实例
+		if len(account.ESSRegionIds) > 0 {
+			if err := services.SyncESSInfo(account.Name, account.ESSRegionIds, account.AccessKey, account.AccessSecret); err != nil {
+				logger.Log.Errorf("ESS 同步失败 (账户=%s): %v", account.Name, err)
+			}
+		}
+		// 同步弹性容器实例
+		if len(account.ECIRegionIds) > 0 {
+			if err := services.SyncECIInfo(account.Name, account.ECIRegionIds, account.AccessKey, account.AccessSecret); err != nil {
+				logger.Log.Errorf("ECI 同步失败 (账户=%s): %v", account.Name, err)
+			}
+		}
+		// 同步函数计算服务、函数及触发器
+		if len(account.FCRegionIds) > 0 {
+			if err := services.SyncFCInfo(account.Name, account.FCRegionIds, account.AccessKey, account.AccessSecret); err != nil {
+				logger.Log.Errorf("FC 同步失败 (账户=%s): %v", account.Name, err)
+			}
+		}
+		// 同步日志服务 Project 及 Logstore
+		if len(account.SLSRegionIds) > 0 {
+			if err := services.SyncSLSInfo(account.Name, account.SLSRegionIds, account.AccessKey, account.AccessSecret); err != nil {
+				logger.Log.Errorf("SLS 同步失败 (账户=%s): %v", account.Name, err)
+			}
+		}
+		// 同步容器镜像服务企业版实例、命名空间及镜像仓库
+		if len(account.ACRRegionIds) > 0 {
+			if err := services.SyncACRInfo(account.Name, account.ACRRegionIds, account.AccessKey, account.AccessSecret); err != nil {
+				logger.Log.Errorf("ACR 同步失败 (账户=%s): %v", account.Name, err)
+			}
+		}
+		// 同步分析型数据库信息（Elasticsearch、ClickHouse、AnalyticDB、Lindorm 使用相同的区域配置）
+		if len(account.BigDataRegionIds) > 0 {
+			if err := services.SyncElasticsearchInfo(account.Name, account.BigDataRegionIds, account.AccessKey, account.AccessSecret); err != nil {
+				logger.Log.Errorf("Elasticsearch 同步失败 (账户=%s): %v", account.Name, err)
+			}
+			if err := services.SyncClickHouseInfo(account.Name, account.BigDataRegionIds, account.AccessKey, account.AccessSecret); err != nil {
+				logger.Log.Errorf("ClickHouse 同步失败 (账户=%s): %v", account.Name, err)
+			}
+			if err := services.SyncADBInfo(account.Name, account.BigDataRegionIds, account.AccessKey, account.AccessSecret); err != nil {
+				logger.Log.Errorf("AnalyticDB MySQL 同步失败 (账户=%s): %v", account.Name, err)
+			}
+			if err := services.SyncGPDBInfo(account.Name, account.BigDataRegionIds, account.AccessKey, account.AccessSecret); err != nil {
+				logger.Log.Errorf("AnalyticDB PostgreSQL 同步失败 (账户=%s): %v", account.Name, err)
+			}
+			if err := services.SyncLindormInfo(account.Name, account.BigDataRegionIds, account.AccessKey, account.AccessSecret); err != nil {
+				logger.Log.Errorf("Lindorm 同步失败 (账户=%s): %v", account.Name, err)
+			}
+		}
+		// 同步 VPC、交换机、路由表及 NAT 网关信息
+		if len(account.VPCRegionIds) > 0 {
+			if err := services.SyncVPCInfo(account.Name, account.VPCRegionIds, account.AccessKey, account.AccessSecret); err != nil {
+				logger.Log.Errorf("VPC 同步失败 (账户=%s): %v", account.Name, err)
+			}
+			// 同步弹性公网 IP（与 VPC 使用相同的区域）
+			if err := services.SyncEIPInfo(account.Name, account.VPCRegionIds, account.AccessKey, account.AccessSecret); err != nil {
+				logger.Log.Errorf("EIP 同步失败 (账户=%s): %v", account.Name, err)
+			}
+			// 同步 VPN 网关、IPsec 连接、边界路由器及物理专线（与 VPC 使用相同的区域）
+			if err := services.SyncHybridNetworkInfo(account.Name, account.VPCRegionIds, account.AccessKey, account.AccessSecret); err != nil {
+				logger.Log.Errorf("混合云网络同步失败 (账户=%s): %v", account.Name, err)
+			}
+		}
+		// 同步云企业网实例、已加载网络实例及带宽包
+		if len(account.CENRegionIds) > 0 {
+			if err := services.SyncCENInfo(account.Name, account.CENRegionIds, account.AccessKey, account.AccessSecret); err != nil {
+				logger.Log.Errorf("CEN 同步失败 (账户=%s): %v", account.Name, err)
+			}
+		}
+		// 同步实例账单费用
+		if len(account.BSSRegionIds) > 0 {
+			if err := services.SyncBillingInfo(account.Name, account.BSSRegionIds, account.AccessKey, account.AccessSecret, account.BillingMonths); err != nil {
+				logger.Log.Errorf("账单同步失败 (账户=%s): %v", account.Name, err)
+			}
+		}
+		// 同步 ECS、RDS、Redis、PolarDB 云监控指标汇总（可选）
+		if len(account.MetricRegionIds) > 0 {
+			if err := services.SyncMetricInfo(account.Name, account.MetricRegionIds, account.AccessKey, account.AccessSecret, account.MetricDays); err != nil {
+				logger.Log.Errorf("云监控指标同步失败 (账户=%s): %v", account.Name, err)
+			}
+		}
+	}
+
+	// 记录当日资源统计快照，用于趋势图及容量规划
+	snapshotDate := time.Now().Format("2006-01-02")
+	if err := database.SaveStatsSnapshot(snapshotDate); err != nil {
+		logger.Log.Errorf("保存统计快照失败: %v", err)
+	}
+	// 记录当日资源快照，用于对比两个日期之间的资源变更
+	if err := database.SaveResourceSnapshot(snapshotDate); err != nil {
+		logger.Log.Errorf("保存资源快照失败: %v", err)
+	}
+	// 按保留天数清理过期的资源快照
+	if cfg.SnapshotRetentionDays > 0 {
+		cutoff := time.Now().AddDate(0, 0, -cfg.SnapshotRetentionDays).Format("2006-01-02")
+		if deleted, err := database.DeleteResourceSnapshotsBefore(cutoff); err != nil {
+			logger.Log.Errorf("清理过期资源快照失败: %v", err)
+		} else {
+			logger.Log.Infof("清理过期资源快照完成, 保留天数=%d, 删除=%d 条", cfg.SnapshotRetentionDays, deleted)
+		}
 	}
 
 	// 6. 启动 Gin Web 服务，提供RESTful查询接口
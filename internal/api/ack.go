@@ -0,0 +1,88 @@
+package api
+
+import (
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+	"github.com/WillemCode/AliCloud_Resources/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// ACK 集群及其节点池
+type ACKClusterDetail struct {
+	database.ACKClusterRecord
+	NodePools []database.ACKNodePoolRecord
+	NodeCount int // 已同步的 ECS 节点数
+}
+
+// 处理 ACK 集群列表请求
+func handleACKClusterList(c *gin.Context) {
+	page, pageSize := getPaginationParams(c)
+
+	clusters, err := database.ListACKClusterRecords()
+	if err != nil {
+		logger.Log.Error("查询 ACK 集群数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query ACK cluster data"})
+		return
+	}
+	nodePools, err := database.ListACKNodePools()
+	if err != nil {
+		logger.Log.Error("查询 ACK 节点池数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query ACK node pool data"})
+		return
+	}
+	ecsRecords, err := database.ListECSRecords()
+	if err != nil {
+		logger.Log.Error("查询 ECS 数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query ECS data"})
+		return
+	}
+	nodeCounts := map[string]int{}
+	for _, rec := range ecsRecords {
+		if rec.ClusterID != "" {
+			nodeCounts[rec.ClusterID]++
+		}
+	}
+
+	// 应用分页
+	total := len(clusters)
+	paginatedData := applyPagination(clusters, page, pageSize)
+
+	details := make([]ACKClusterDetail, 0, len(paginatedData))
+	for _, rec := range paginatedData {
+		details = append(details, ACKClusterDetail{
+			ACKClusterRecord: rec,
+			NodePools:        nodePools[rec.ClusterID],
+			NodeCount:        nodeCounts[rec.ClusterID],
+		})
+	}
+
+	c.JSON(200, PaginatedResponse{
+		Data:     details,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// 处理 ACK 集群节点列表请求：返回属于该集群的 ECS 实例，可通过 nodepool_id 参数筛选节点池
+func handleACKClusterNodes(c *gin.Context) {
+	page, pageSize := getPaginationParams(c)
+	clusterID := c.Param("id")
+	nodePoolID := c.Query("nodepool_id")
+
+	ecsRecords, err := database.ListECSRecords()
+	if err != nil {
+		logger.Log.Error("查询 ECS 数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query ECS data"})
+		return
+	}
+	nodes := filterRecords(ecsRecords, func(r database.ECSRecord) bool {
+		return r.ClusterID == clusterID && (nodePoolID == "" || r.NodePoolID == nodePoolID)
+	})
+
+	c.JSON(200, PaginatedResponse{
+		Data:     applyPagination(nodes, page, pageSize),
+		Total:    len(nodes),
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
@@ -0,0 +1,87 @@
+package api
+
+import (
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+	"github.com/WillemCode/AliCloud_Resources/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// ACR 实例及其命名空间
+type ACRInstanceDetail struct {
+	database.ACRInstanceRecord
+	Namespaces      []database.ACRNamespaceRecord
+	RepositoryCount int // 镜像仓库数量
+}
+
+// 处理 ACR 实例列表请求
+func handleACRInstanceList(c *gin.Context) {
+	page, pageSize := getPaginationParams(c)
+
+	instances, err := database.ListACRInstanceRecords()
+	if err != nil {
+		logger.Log.Error("查询 ACR 实例数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query ACR instance data"})
+		return
+	}
+	namespaces, err := database.ListACRNamespaces()
+	if err != nil {
+		logger.Log.Error("查询 ACR 命名空间数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query ACR namespace data"})
+		return
+	}
+	repositories, err := database.ListACRRepositoryRecords()
+	if err != nil {
+		logger.Log.Error("查询 ACR 镜像仓库数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query ACR repository data"})
+		return
+	}
+	repositoryCounts := map[string]int{}
+	for _, rec := range repositories {
+		repositoryCounts[rec.InstanceID]++
+	}
+
+	// 应用分页
+	total := len(instances)
+	paginatedData := applyPagination(instances, page, pageSize)
+
+	details := make([]ACRInstanceDetail, 0, len(paginatedData))
+	for _, rec := range paginatedData {
+		details = append(details, ACRInstanceDetail{
+			ACRInstanceRecord: rec,
+			Namespaces:        namespaces[rec.InstanceID],
+			RepositoryCount:   repositoryCounts[rec.InstanceID],
+		})
+	}
+
+	c.JSON(200, PaginatedResponse{
+		Data:     details,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// 处理 ACR 镜像仓库列表请求，支持 instance_id=<实例ID> 及 namespace=<命名空间> 筛选
+func handleACRRepositoryList(c *gin.Context) {
+	page, pageSize := getPaginationParams(c)
+
+	repositories, err := database.ListACRRepositoryRecords()
+	if err != nil {
+		logger.Log.Error("查询 ACR 镜像仓库数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query ACR repository data"})
+		return
+	}
+	if instanceID := c.Query("instance_id"); instanceID != "" {
+		repositories = filterRecords(repositories, func(r database.ACRRepositoryRecord) bool { return r.InstanceID == instanceID })
+	}
+	if namespace := c.Query("namespace"); namespace != "" {
+		repositories = filterRecords(repositories, func(r database.ACRRepositoryRecord) bool { return r.NamespaceName == namespace })
+	}
+
+	c.JSON(200, PaginatedResponse{
+		Data:     applyPagination(repositories, page, pageSize),
+		Total:    len(repositories),
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
@@ -0,0 +1,39 @@
+package api
+
+import (
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+	"github.com/WillemCode/AliCloud_Resources/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// isAnalyticProduct 判断是否为分析型数据库产品标识
+func isAnalyticProduct(product string) bool {
+	switch product {
+	case database.AnalyticProductElasticsearch, database.AnalyticProductClickHouse, database.AnalyticProductADBMySQL,
+		database.AnalyticProductADBPostgreSQL, database.AnalyticProductLindorm:
+		return true
+	}
+	return false
+}
+
+// 处理分析型数据库列表请求，支持 product=<产品> 筛选
+func handleAnalyticList(c *gin.Context) {
+	page, pageSize := getPaginationParams(c)
+
+	instances, err := database.ListAnalyticInstanceRecords()
+	if err != nil {
+		logger.Log.Error("查询分析型数据库数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query analytic database data"})
+		return
+	}
+	if product := c.Query("product"); product != "" {
+		instances = filterRecords(instances, func(r database.AnalyticInstanceRecord) bool { return r.Product == product })
+	}
+
+	c.JSON(200, PaginatedResponse{
+		Data:     applyPagination(instances, page, pageSize),
+		Total:    len(instances),
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
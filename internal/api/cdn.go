@@ -0,0 +1,76 @@
+package api
+
+import (
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+	"github.com/WillemCode/AliCloud_Resources/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// 源站及其对应的云资源
+type CDNOrigin struct {
+	database.CDNOriginRecord
+	Targets []DNSTarget
+}
+
+// 加速域名及其源站
+type CDNDomainDetail struct {
+	database.CDNDomainRecord
+	Origins []CDNOrigin
+}
+
+// 处理 CDN/DCDN 加速域名列表请求，可通过 product 参数筛选 cdn 或 dcdn
+// 源站地址与已同步的 ECS、EIP、负载均衡、OSS 匹配，域名源站会沿 DNS 解析链继续查找
+func handleCDNList(c *gin.Context) {
+	page, pageSize := getPaginationParams(c)
+
+	domains, err := database.ListCDNDomainRecords()
+	if err != nil {
+		logger.Log.Error("查询 CDN 数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query CDN data"})
+		return
+	}
+	if product := c.Query("product"); product != "" {
+		domains = filterRecords(domains, func(r database.CDNDomainRecord) bool { return r.Product == product })
+	}
+	origins, err := database.ListCDNOrigins()
+	if err != nil {
+		logger.Log.Error("查询 CDN 源站数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query CDN data"})
+		return
+	}
+	resolver, err := newDNSResolver()
+	if err != nil {
+		logger.Log.Error("构建 DNS 解析索引失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query CDN data"})
+		return
+	}
+
+	results := make([]CDNDomainDetail, 0, pageSize)
+	for _, domain := range applyPagination(domains, page, pageSize) {
+		detail := CDNDomainDetail{CDNDomainRecord: domain, Origins: []CDNOrigin{}}
+		for _, origin := range origins[domain.DomainName] {
+			detail.Origins = append(detail.Origins, CDNOrigin{CDNOriginRecord: origin, Targets: resolver.originTargets(origin.Content)})
+		}
+		results = append(results, detail)
+	}
+
+	c.JSON(200, PaginatedResponse{
+		Data:     results,
+		Total:    len(domains),
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// 查找源站地址对应的云资源：先直接匹配 IP/域名，未命中时沿已同步的 DNS 解析链查找
+func (r *dnsResolver) originTargets(content string) []DNSTarget {
+	address := normalizeHostname(content)
+	if targets := r.resources[address]; len(targets) > 0 {
+		return targets
+	}
+	targets := []DNSTarget{}
+	for _, step := range r.resolve(address).Chain {
+		targets = append(targets, step.Targets...)
+	}
+	return targets
+}
@@ -0,0 +1,220 @@
+package api
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+	"github.com/WillemCode/AliCloud_Resources/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// 证书到期报表默认提前天数
+const defaultCertExpiryDays = 30
+
+// 证书及其剩余天数、部署位置
+type CertificateItem struct {
+	database.CertificateRecord
+	DaysRemaining int // 距过期剩余天数，已过期为负数
+	Deployments   []database.CertificateDeploymentRecord
+	CDNDomains    []database.CDNDomainRecord // 使用该证书的 CDN/DCDN 加速域名
+}
+
+// 处理证书列表请求，按剩余天数排序，order=desc 时倒序；可通过 source 参数筛选 cas/slb/cdn
+func handleCertificateList(c *gin.Context) {
+	page, pageSize := getPaginationParams(c)
+
+	items, err := loadCertificateItems()
+	if err != nil {
+		logger.Log.Error("查询证书数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query certificate data"})
+		return
+	}
+	if source := c.Query("source"); source != "" {
+		items = filterRecords(items, func(r CertificateItem) bool { return r.Source == source })
+	}
+	sortCertificateItems(items, c.DefaultQuery("order", "asc") == "desc")
+
+	c.JSON(200, PaginatedResponse{
+		Data:     applyPagination(items, page, pageSize),
+		Total:    len(items),
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// 处理证书到期报表请求：列出 days 天内（默认 30 天）到期及已过期的证书，按剩余天数升序
+func handleExpiringCertificates(c *gin.Context) {
+	page, pageSize := getPaginationParams(c)
+
+	days := defaultCertExpiryDays
+	if daysStr := c.Query("days"); daysStr != "" {
+		d, err := strconv.Atoi(daysStr)
+		if err != nil || d < 0 {
+			c.JSON(400, gin.H{"error": "invalid days: " + daysStr})
+			return
+		}
+		days = d
+	}
+
+	items, err := loadCertificateItems()
+	if err != nil {
+		logger.Log.Error("查询证书数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query certificate data"})
+		return
+	}
+	items = filterRecords(items, func(r CertificateItem) bool { return r.NotAfter != "" && r.DaysRemaining <= days })
+	sortCertificateItems(items, false)
+
+	c.JSON(200, PaginatedResponse{
+		Data:     applyPagination(items, page, pageSize),
+		Total:    len(items),
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// 加载全部证书，计算剩余天数并关联引用证书的负载均衡监听及 CDN/DCDN 加速域名
+func loadCertificateItems() ([]CertificateItem, error) {
+	certs, err := database.ListCertificateRecords()
+	if err != nil {
+		return nil, err
+	}
+	deployments, err := database.ListCertificateDeployments()
+	if err != nil {
+		return nil, err
+	}
+	domains, err := database.ListCDNDomainRecords()
+	if err != nil {
+		return nil, err
+	}
+
+	// 监听上的证书ID → 监听
+	byCertID := map[string][]database.CertificateDeploymentRecord{}
+	for _, dep := range deployments {
+		for _, id := range splitIDs(dep.CertificateID) {
+			key := certificateKey(dep.LBType, id)
+			byCertID[key] = append(byCertID[key], dep)
+		}
+	}
+	// 由 CAS 证书上传到 CLB 的证书，其部署也计入对应的 CAS 证书
+	slbByCAS := map[string][]string{}
+	for _, cert := range certs {
+		if cert.Source == database.CertSourceSLB && cert.CASCertID != "" {
+			slbByCAS[cert.CASCertID] = append(slbByCAS[cert.CASCertID], cert.CertID)
+		}
+	}
+
+	// 加速域名上的证书ID与 CAS 证书ID一致，未匹配到 CAS 证书的按证书ID（缺失时按域名）单独成项
+	casCertIDs := map[string]bool{}
+	for _, cert := range certs {
+		if cert.Source == database.CertSourceCAS {
+			casCertIDs[cert.CertID] = true
+		}
+	}
+	domainsByCertID := map[string][]database.CDNDomainRecord{}
+	var domainOnlyKeys []string
+	for _, domain := range domains {
+		if domain.CertID == "" && domain.CertName == "" {
+			continue
+		}
+		key := domain.CertID
+		if key == "" || !casCertIDs[key] {
+			key = database.CertSourceCDN + ":" + domain.CertID
+			if domain.CertID == "" {
+				key += domain.DomainName
+			}
+			if _, ok := domainsByCertID[key]; !ok {
+				domainOnlyKeys = append(domainOnlyKeys, key)
+			}
+		}
+		domainsByCertID[key] = append(domainsByCertID[key], domain)
+	}
+
+	now := time.Now()
+	items := make([]CertificateItem, 0, len(certs)+len(domainOnlyKeys))
+	for _, cert := range certs {
+		item := CertificateItem{CertificateRecord: cert, DaysRemaining: certificateDaysRemaining(cert.NotAfter, now)}
+		item.Deployments = append(item.Deployments, byCertID[cert.CertID]...)
+		if cert.Source == database.CertSourceCAS {
+			for _, slbCertID := range slbByCAS[cert.CertID] {
+				item.Deployments = append(item.Deployments, byCertID[slbCertID]...)
+			}
+			item.CDNDomains = domainsByCertID[cert.CertID]
+		}
+		items = append(items, item)
+	}
+	for _, key := range domainOnlyKeys {
+		items = append(items, domainCertificateItem(domainsByCertID[key], now))
+	}
+	return items, nil
+}
+
+// domainCertificateItem 由加速域名上的证书信息构造证书项，用于未在 CAS 中同步到的证书
+func domainCertificateItem(domains []database.CDNDomainRecord, now time.Time) CertificateItem {
+	first := domains[0]
+	names := make([]string, 0, len(domains))
+	for _, domain := range domains {
+		names = append(names, domain.DomainName)
+	}
+	cert := database.CertificateRecord{
+		CertID:     first.CertID,
+		Source:     database.CertSourceCDN,
+		CloudName:  first.CloudName,
+		Name:       first.CertName,
+		CommonName: first.DomainName,
+		SANs:       strings.Join(names, ","),
+		Issuer:     first.CertIssuer,
+		NotAfter:   cdnCertificateExpireTime(first.CertExpireTime),
+	}
+	return CertificateItem{
+		CertificateRecord: cert,
+		DaysRemaining:     certificateDaysRemaining(cert.NotAfter, now),
+		CDNDomains:        domains,
+	}
+}
+
+// cdnCertificateExpireTime 将加速域名证书的过期时间（UTC，ISO 8601 或 "yyyy-MM-dd HH:mm:ss"）转换为本地时间字符串，无法解析时返回空串
+func cdnCertificateExpireTime(expireTime string) string {
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04Z", "2006-01-02 15:04:05"} {
+		if t, err := time.Parse(layout, expireTime); err == nil {
+			return t.Local().Format("2006-01-02 15:04:05")
+		}
+	}
+	return ""
+}
+
+// ALB/NLB 监听引用的证书ID格式为 "<CAS 证书ID>-<区域>"，取前缀与 CAS 证书匹配；CLB 直接使用服务器证书ID
+func certificateKey(lbType, id string) string {
+	if lbType == database.LBTypeALB || lbType == database.LBTypeNLB {
+		if idx := strings.Index(id, "-"); idx > 0 {
+			return id[:idx]
+		}
+	}
+	return id
+}
+
+// 计算距过期时间的剩余天数（向下取整），已过期为负数
+func certificateDaysRemaining(notAfter string, now time.Time) int {
+	t, err := time.ParseInLocation("2006-01-02 15:04:05", notAfter, time.Local)
+	if err != nil {
+		return 0
+	}
+	return int(math.Floor(t.Sub(now).Hours() / 24))
+}
+
+// 按剩余天数排序，缺少过期时间的证书始终排在最后
+func sortCertificateItems(items []CertificateItem, desc bool) {
+	sort.SliceStable(items, func(i, j int) bool {
+		if (items[i].NotAfter == "") != (items[j].NotAfter == "") {
+			return items[j].NotAfter == ""
+		}
+		if desc {
+			return items[i].DaysRemaining > items[j].DaysRemaining
+		}
+		return items[i].DaysRemaining < items[j].DaysRemaining
+	})
+}
@@ -0,0 +1,259 @@
+package api
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+	"github.com/WillemCode/AliCloud_Resources/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// 实例费用及其在资源清单中对应的资源
+type CostDetail struct {
+	database.CostRecord
+	ResourceType string // 资源类型（ecs/rds/slb/redis/polardb，其他产品为产品代码）
+	ResourceName string // 资源清单中的实例名称
+	InInventory  bool   // 是否能在已同步的资源清单中找到该实例
+}
+
+// 按维度汇总的费用
+type CostGroup struct {
+	Key               string  // 账户名称、资源类型或标签值
+	PretaxGrossAmount float64 // 原价合计
+	PretaxAmount      float64 // 应付金额合计
+	InstanceCount     int     // 实例数
+}
+
+// 资源清单中的实例
+type inventoryResource struct {
+	Type string
+	Name string
+}
+
+// 账单产品代码与资源类型的对应关系
+var costResourceTypes = map[string]string{
+	"ecs":     "ecs",
+	"rds":     "rds",
+	"slb":     "slb",
+	"kvstore": "redis",
+	"redisa":  "redis",
+	"polardb": "polardb",
+	"dds":     "mongodb",
+}
+
+// costResourceType 将账单产品代码映射为资源类型，未知产品返回产品代码本身
+func costResourceType(productCode string) string {
+	if t, ok := costResourceTypes[strings.ToLower(productCode)]; ok {
+		return t
+	}
+	return strings.ToLower(productCode)
+}
+
+// 处理实例费用列表请求，支持 cycle=<YYYY-MM>、account=<账户>、type=<资源类型> 筛选，按应付金额从高到低排序
+func handleCostList(c *gin.Context) {
+	page, pageSize := getPaginationParams(c)
+
+	cycle, details, err := loadCostDetails(c.Query("cycle"))
+	if err != nil {
+		logger.Log.Error("查询账单费用数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query cost data"})
+		return
+	}
+	if account := c.Query("account"); account != "" {
+		details = filterRecords(details, func(r CostDetail) bool { return r.CloudName == account })
+	}
+	if resourceType := c.Query("type"); resourceType != "" {
+		details = filterRecords(details, func(r CostDetail) bool { return r.ResourceType == resourceType })
+	}
+
+	c.JSON(200, gin.H{
+		"cycle":    cycle,
+		"data":     applyPagination(details, page, pageSize),
+		"total":    len(details),
+		"page":     page,
+		"pageSize": pageSize,
+	})
+}
+
+// 处理费用排行请求：by=instance（默认）/account/type/tag，tag 维度需指定 tag_key，limit 默认 10
+func handleTopCosts(c *gin.Context) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	if err != nil || limit < 1 {
+		limit = 10
+	}
+	by := c.DefaultQuery("by", "instance")
+	tagKey := c.Query("tag_key")
+	if by == "tag" && tagKey == "" {
+		c.JSON(400, gin.H{"error": "tag_key is required when by=tag"})
+		return
+	}
+
+	cycle, details, err := loadCostDetails(c.Query("cycle"))
+	if err != nil {
+		logger.Log.Error("查询账单费用数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query cost data"})
+		return
+	}
+
+	var keyOf func(CostDetail) string
+	switch by {
+	case "instance":
+		total := len(details)
+		if len(details) > limit {
+			details = details[:limit]
+		}
+		c.JSON(200, gin.H{"cycle": cycle, "by": by, "data": details, "total": total})
+		return
+	case "account":
+		keyOf = func(r CostDetail) string { return r.CloudName }
+	case "type":
+		keyOf = func(r CostDetail) string { return r.ResourceType }
+	case "tag":
+		keyOf = func(r CostDetail) string {
+			if value, ok := parseBillTags(r.Tag)[tagKey]; ok {
+				return value
+			}
+			return "(none)"
+		}
+	default:
+		c.JSON(400, gin.H{"error": "by must be one of instance, account, type, tag"})
+		return
+	}
+
+	groups := map[string]*CostGroup{}
+	// 同一实例可能按多个产品代码出账，实例数按实例ID去重
+	instances := map[string]map[string]bool{}
+	for _, rec := range details {
+		key := keyOf(rec)
+		group, ok := groups[key]
+		if !ok {
+			group = &CostGroup{Key: key}
+			groups[key] = group
+			instances[key] = map[string]bool{}
+		}
+		group.PretaxGrossAmount += rec.PretaxGrossAmount
+		group.PretaxAmount += rec.PretaxAmount
+		if !instances[key][rec.InstanceID] {
+			instances[key][rec.InstanceID] = true
+			group.InstanceCount++
+		}
+	}
+	results := make([]CostGroup, 0, len(groups))
+	for _, group := range groups {
+		results = append(results, *group)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].PretaxAmount != results[j].PretaxAmount {
+			return results[i].PretaxAmount > results[j].PretaxAmount
+		}
+		return results[i].Key < results[j].Key
+	})
+	total := len(results)
+	if len(results) > limit {
+		results = results[:limit]
+	}
+
+	c.JSON(200, gin.H{"cycle": cycle, "by": by, "data": results, "total": total})
+}
+
+// loadCostDetails 查询指定账期（为空时取最近一个已同步账期）的实例费用，并关联 ECS/RDS/SLB/Redis/PolarDB 资源清单
+func loadCostDetails(cycle string) (string, []CostDetail, error) {
+	if cycle == "" {
+		cycles, err := database.ListBillingCycles()
+		if err != nil {
+			return "", nil, err
+		}
+		if len(cycles) == 0 {
+			return "", []CostDetail{}, nil
+		}
+		cycle = cycles[0]
+	}
+
+	costs, err := database.ListCostRecords(cycle)
+	if err != nil {
+		return "", nil, err
+	}
+	inventory, err := loadCostInventory()
+	if err != nil {
+		return "", nil, err
+	}
+
+	details := make([]CostDetail, 0, len(costs))
+	for _, rec := range costs {
+		detail := CostDetail{CostRecord: rec, ResourceType: costResourceType(rec.ProductCode)}
+		if res, ok := inventory[rec.InstanceID]; ok {
+			detail.ResourceType = res.Type
+			detail.ResourceName = res.Name
+			detail.InInventory = true
+		}
+		details = append(details, detail)
+	}
+	return cycle, details, nil
+}
+
+// loadCostInventory 汇总 ECS、RDS、SLB、Redis、PolarDB 实例ID到资源类型及名称的映射
+func loadCostInventory() (map[string]inventoryResource, error) {
+	inventory := map[string]inventoryResource{}
+
+	ecsRecords, err := database.ListECSRecords()
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range ecsRecords {
+		inventory[r.InstanceID] = inventoryResource{Type: "ecs", Name: r.InstanceName}
+	}
+
+	rdsRecords, err := database.ListRDSRecords()
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range rdsRecords {
+		inventory[r.InstanceID] = inventoryResource{Type: "rds", Name: r.Description}
+	}
+
+	slbRecords, err := database.ListSLBRecords()
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range slbRecords {
+		inventory[r.InstanceID] = inventoryResource{Type: "slb", Name: r.LoadBalancerName}
+	}
+
+	redisRecords, err := database.ListRedisRecords()
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range redisRecords {
+		inventory[r.InstanceID] = inventoryResource{Type: "redis", Name: r.InstanceName}
+	}
+
+	polarRecords, err := database.ListPolarDBRecords()
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range polarRecords {
+		inventory[r.InstanceID] = inventoryResource{Type: "polardb", Name: r.Description}
+	}
+
+	return inventory, nil
+}
+
+// parseBillTags 解析账单中的标签字段，格式为 "key:k1 value:v1; key:k2 value:v2"
+func parseBillTags(tag string) map[string]string {
+	tags := map[string]string{}
+	for _, part := range strings.Split(tag, ";") {
+		part = strings.TrimSpace(part)
+		if !strings.HasPrefix(part, "key:") {
+			continue
+		}
+		kv := strings.SplitN(strings.TrimPrefix(part, "key:"), " value:", 2)
+		if len(kv) == 2 {
+			tags[kv[0]] = kv[1]
+		} else {
+			tags[kv[0]] = ""
+		}
+	}
+	return tags
+}
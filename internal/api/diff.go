@@ -0,0 +1,187 @@
+package api
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+	"github.com/WillemCode/AliCloud_Resources/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// 单个资源类型的变更数量
+type DiffSummary struct {
+	ResourceType string
+	Added        int
+	Released     int
+	Changed      int
+}
+
+// 处理资源变更对比请求：对比 from、to 两个日期（YYYY-MM-DD，to 默认今天）的资源快照，列出新增、释放及字段变更的资源
+// 支持 account=<账户>、region=<区域>、type=<资源类型>、action=<added|released|changed> 筛选，format=markdown|csv 导出
+func handleDiff(c *gin.Context) {
+	from, to := c.Query("from"), c.DefaultQuery("to", time.Now().Format("2006-01-02"))
+	if from == "" {
+		c.JSON(400, gin.H{"error": "from is required"})
+		return
+	}
+	fromDate, err := time.Parse("2006-01-02", from)
+	if err != nil {
+		c.JSON(400, gin.H{"error": "invalid from: " + from})
+		return
+	}
+	toDate, err := time.Parse("2006-01-02", to)
+	if err != nil {
+		c.JSON(400, gin.H{"error": "invalid to: " + to})
+		return
+	}
+	if fromDate.After(toDate) {
+		c.JSON(400, gin.H{"error": "from must not be after to"})
+		return
+	}
+	format := c.DefaultQuery("format", "json")
+	switch format {
+	case "json", "markdown", "csv":
+	default:
+		c.JSON(400, gin.H{"error": "format must be one of json, markdown, csv"})
+		return
+	}
+
+	filter := database.StatsFilter{
+		CloudName:    c.Query("account"),
+		RegionID:     c.Query("region"),
+		ResourceType: c.Query("type"),
+	}
+	diff, err := database.DiffResourceSnapshots(filter, from, to)
+	if errors.Is(err, database.ErrNoSnapshot) {
+		c.JSON(404, gin.H{"error": "no snapshot found on or before the given date"})
+		return
+	}
+	if err != nil {
+		logger.Log.Error("对比资源快照失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to diff resource snapshots"})
+		return
+	}
+	if action := c.Query("action"); action != "" {
+		diff.Changes = filterRecords(diff.Changes, func(r database.ResourceChange) bool { return r.Action == action })
+	}
+
+	switch format {
+	case "markdown":
+		c.Header("Content-Type", "text/markdown; charset=utf-8")
+		c.Status(200)
+		if err := WriteDiffMarkdown(c.Writer, diff); err != nil {
+			logger.Log.Error("导出资源变更 Markdown 失败: ", err)
+		}
+	case "csv":
+		c.Header("Content-Type", "text/csv; charset=utf-8")
+		c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=diff_%s_%s.csv", diff.From, diff.To))
+		c.Status(200)
+		if err := WriteDiffCSV(c.Writer, diff); err != nil {
+			logger.Log.Error("导出资源变更 CSV 失败: ", err)
+		}
+	default:
+		c.JSON(200, gin.H{
+			"from":    diff.From,
+			"to":      diff.To,
+			"summary": summarizeDiff(diff.Changes),
+			"data":    diff.Changes,
+			"total":   len(diff.Changes),
+		})
+	}
+}
+
+// summarizeDiff 按资源类型统计新增、释放及变更数量
+func summarizeDiff(changes []database.ResourceChange) []DiffSummary {
+	byType := map[string]*DiffSummary{}
+	for _, ch := range changes {
+		s, ok := byType[ch.ResourceType]
+		if !ok {
+			s = &DiffSummary{ResourceType: ch.ResourceType}
+			byType[ch.ResourceType] = s
+		}
+		switch ch.Action {
+		case database.DiffAdded:
+			s.Added++
+		case database.DiffReleased:
+			s.Released++
+		case database.DiffChanged:
+			s.Changed++
+		}
+	}
+
+	summary := make([]DiffSummary, 0, len(byType))
+	for _, s := range byType {
+		summary = append(summary, *s)
+	}
+	sort.Slice(summary, func(i, j int) bool { return summary[i].ResourceType < summary[j].ResourceType })
+	return summary
+}
+
+// WriteDiffMarkdown 以 Markdown 格式输出资源变更，每个资源类型一节
+func WriteDiffMarkdown(w io.Writer, diff database.ResourceDiff) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Inventory diff %s → %s\n\n", diff.From, diff.To)
+	if len(diff.Changes) == 0 {
+		b.WriteString("No changes.\n")
+	}
+
+	summary := summarizeDiff(diff.Changes)
+	for _, s := range summary {
+		fmt.Fprintf(&b, "## %s (added %d, released %d, changed %d)\n\n", s.ResourceType, s.Added, s.Released, s.Changed)
+		b.WriteString("| Action | Resource ID | Name | Account | Region | Changed fields |\n")
+		b.WriteString("| --- | --- | --- | --- | --- | --- |\n")
+		for _, ch := range diff.Changes {
+			if ch.ResourceType != s.ResourceType {
+				continue
+			}
+			fields := make([]string, 0, len(ch.Fields))
+			for _, f := range ch.Fields {
+				fields = append(fields, fmt.Sprintf("%s: %s → %s", f.Field, f.Before, f.After))
+			}
+			fmt.Fprintf(&b, "| %s | %s | %s | %s | %s | %s |\n",
+				ch.Action, markdownCell(ch.ResourceID), markdownCell(ch.Name), markdownCell(ch.CloudName),
+				markdownCell(ch.RegionID), markdownCell(strings.Join(fields, "<br>")),
+			)
+		}
+		b.WriteString("\n")
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// WriteDiffCSV 以 CSV 格式输出资源变更，字段变更每个字段一行，新增及释放的资源各一行
+func WriteDiffCSV(w io.Writer, diff database.ResourceDiff) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"resource_type", "action", "resource_id", "name", "cloud_name", "region_id", "field", "before", "after"}); err != nil {
+		return err
+	}
+	for _, ch := range diff.Changes {
+		row := []string{ch.ResourceType, ch.Action, ch.ResourceID, ch.Name, ch.CloudName, ch.RegionID}
+		if len(ch.Fields) == 0 {
+			if err := cw.Write(append(row, "", "", "")); err != nil {
+				return err
+			}
+			continue
+		}
+		for _, f := range ch.Fields {
+			if err := cw.Write(append(row[:6:6], f.Field, f.Before, f.After)); err != nil {
+				return err
+			}
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// markdownCell 转义表格单元格中的竖线及换行
+func markdownCell(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	return strings.ReplaceAll(s, "\n", " ")
+}
@@ -0,0 +1,97 @@
+package api
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+)
+
+func TestWriteDiffCSV(t *testing.T) {
+	header := "resource_type,action,resource_id,name,cloud_name,region_id,field,before,after\n"
+	tests := []struct {
+		name    string
+		changes []database.ResourceChange
+		want    string
+	}{
+		{"no changes", nil, header},
+		{"added", []database.ResourceChange{
+			{ResourceType: "ecs", ResourceID: "i-1", Name: "web", CloudName: "a", RegionID: "cn-hangzhou", Action: database.DiffAdded},
+		}, header + "ecs,added,i-1,web,a,cn-hangzhou,,,\n"},
+		{"released", []database.ResourceChange{
+			{ResourceType: "rds", ResourceID: "rm-1", CloudName: "a", RegionID: "cn-hangzhou", Action: database.DiffReleased},
+		}, header + "rds,released,rm-1,,a,cn-hangzhou,,,\n"},
+		{"changed with several fields", []database.ResourceChange{
+			{ResourceType: "ecs", ResourceID: "i-1", Name: "web", CloudName: "a", RegionID: "cn-hangzhou", Action: database.DiffChanged, Fields: []database.FieldChange{
+				{Field: "cpu", Before: "2", After: "4"},
+				{Field: "status", Before: "Running", After: "Stopped"},
+				{Field: "vpc_id", Before: "", After: "vpc-1"},
+			}},
+		}, header +
+			"ecs,changed,i-1,web,a,cn-hangzhou,cpu,2,4\n" +
+			"ecs,changed,i-1,web,a,cn-hangzhou,status,Running,Stopped\n" +
+			"ecs,changed,i-1,web,a,cn-hangzhou,vpc_id,,vpc-1\n"},
+		{"quoted values", []database.ResourceChange{
+			{ResourceType: "slb", ResourceID: "lb-1", Name: "web, api", CloudName: "a", Action: database.DiffChanged, Fields: []database.FieldChange{
+				{Field: "description", Before: `say "hi"`, After: "line1\nline2"},
+			}},
+		}, header + "slb,changed,lb-1,\"web, api\",a,,description,\"say \"\"hi\"\"\",\"line1\nline2\"\n"},
+		{"mixed actions", []database.ResourceChange{
+			{ResourceType: "ecs", ResourceID: "i-2", Action: database.DiffAdded},
+			{ResourceType: "ecs", ResourceID: "i-1", Action: database.DiffChanged, Fields: []database.FieldChange{
+				{Field: "cpu", Before: "2", After: "4"},
+				{Field: "memory", Before: "4096", After: "8192"},
+			}},
+			{ResourceType: "ecs", ResourceID: "i-3", Action: database.DiffReleased},
+		}, header +
+			"ecs,added,i-2,,,,,,\n" +
+			"ecs,changed,i-1,,,,cpu,2,4\n" +
+			"ecs,changed,i-1,,,,memory,4096,8192\n" +
+			"ecs,released,i-3,,,,,,\n"},
+	}
+	for _, tt := range tests {
+		var b strings.Builder
+		if err := WriteDiffCSV(&b, database.ResourceDiff{From: "2024-01-01", To: "2024-01-31", Changes: tt.changes}); err != nil {
+			t.Fatalf("%s: WriteDiffCSV() error = %v", tt.name, err)
+		}
+		if b.String() != tt.want {
+			t.Errorf("%s: WriteDiffCSV() =\n%s\nwant\n%s", tt.name, b.String(), tt.want)
+		}
+	}
+}
+
+func TestWriteDiffMarkdown(t *testing.T) {
+	tableHeader := "| Action | Resource ID | Name | Account | Region | Changed fields |\n| --- | --- | --- | --- | --- | --- |\n"
+	tests := []struct {
+		name    string
+		changes []database.ResourceChange
+		want    string
+	}{
+		{"no changes", nil, "# Inventory diff 2024-01-01 → 2024-01-31\n\nNo changes.\n"},
+		{"added and released", []database.ResourceChange{
+			{ResourceType: "ecs", ResourceID: "i-1", Name: "web", CloudName: "a", RegionID: "cn-hangzhou", Action: database.DiffAdded},
+			{ResourceType: "rds", ResourceID: "rm-1", CloudName: "a", RegionID: "cn-hangzhou", Action: database.DiffReleased},
+		}, "# Inventory diff 2024-01-01 → 2024-01-31\n\n" +
+			"## ecs (added 1, released 0, changed 0)\n\n" + tableHeader +
+			"| added | i-1 | web | a | cn-hangzhou |  |\n\n" +
+			"## rds (added 0, released 1, changed 0)\n\n" + tableHeader +
+			"| released | rm-1 |  | a | cn-hangzhou |  |\n\n"},
+		{"changed with several fields", []database.ResourceChange{
+			{ResourceType: "ecs", ResourceID: "i-1", Name: "web|01", CloudName: "a", RegionID: "cn-hangzhou", Action: database.DiffChanged, Fields: []database.FieldChange{
+				{Field: "cpu", Before: "2", After: "4"},
+				{Field: "description", Before: "", After: "a|b\nc"},
+			}},
+		}, "# Inventory diff 2024-01-01 → 2024-01-31\n\n" +
+			"## ecs (added 0, released 0, changed 1)\n\n" + tableHeader +
+			"| changed | i-1 | web\\|01 | a | cn-hangzhou | cpu: 2 → 4<br>description:  → a\\|b c |\n\n"},
+	}
+	for _, tt := range tests {
+		var b strings.Builder
+		if err := WriteDiffMarkdown(&b, database.ResourceDiff{From: "2024-01-01", To: "2024-01-31", Changes: tt.changes}); err != nil {
+			t.Fatalf("%s: WriteDiffMarkdown() error = %v", tt.name, err)
+		}
+		if b.String() != tt.want {
+			t.Errorf("%s: WriteDiffMarkdown() =\n%s\nwant\n%s", tt.name, b.String(), tt.want)
+		}
+	}
+}
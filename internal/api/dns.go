@@ -0,0 +1,220 @@
+package api
+
+import (
+	"strings"
+
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+	"github.com/WillemCode/AliCloud_Resources/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// CNAME 链最大追踪深度，防止记录互相指向时无限递归
+const maxDNSChainDepth = 10
+
+// 解析记录值对应的云资源
+type DNSTarget struct {
+	ResourceType string // 资源类型（ecs/eip/clb/alb/nlb/rds/oss/cdn/dcdn）
+	ResourceID   string // 资源ID
+	Name         string // 资源名称
+	CloudName    string // 账户名称
+	Detail       string `json:",omitempty"` // 附加说明（如 EIP 绑定的实例）
+}
+
+// 解析链中的一条记录及其指向的资源
+type DNSChainStep struct {
+	database.DNSRecord
+	Targets []DNSTarget
+}
+
+// 主机名的完整解析链：主机名 → 解析记录（CNAME 逐级展开）→ 云资源
+type DNSResolution struct {
+	Hostname string
+	Chain    []DNSChainStep
+}
+
+// 处理 DNS 域名列表请求，可通过 type 参数筛选公网（public）或内网（private）域名
+func handleDNSZoneList(c *gin.Context) {
+	page, pageSize := getPaginationParams(c)
+
+	zones, err := database.ListDNSZoneRecords()
+	if err != nil {
+		logger.Log.Error("查询 DNS 域名数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query DNS zone data"})
+		return
+	}
+	if zoneType := c.Query("type"); zoneType != "" {
+		zones = filterRecords(zones, func(r database.DNSZoneRecord) bool { return r.ZoneType == zoneType })
+	}
+
+	c.JSON(200, PaginatedResponse{
+		Data:     applyPagination(zones, page, pageSize),
+		Total:    len(zones),
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// 处理单个域名解析记录请求
+func handleDNSZoneRecords(c *gin.Context) {
+	page, pageSize := getPaginationParams(c)
+
+	records, err := database.ListDNSRecords(c.Param("id"))
+	if err != nil {
+		logger.Log.Error("查询 DNS 解析记录失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query DNS records"})
+		return
+	}
+
+	c.JSON(200, PaginatedResponse{
+		Data:     applyPagination(records, page, pageSize),
+		Total:    len(records),
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// 处理主机名解析请求：返回主机名经 CNAME 展开后的全部记录及其指向的云资源
+func handleDNSResolve(c *gin.Context) {
+	hostname := normalizeHostname(c.Query("hostname"))
+	if hostname == "" {
+		c.JSON(400, gin.H{"error": "hostname is required"})
+		return
+	}
+
+	resolver, err := newDNSResolver()
+	if err != nil {
+		logger.Log.Error("构建 DNS 解析索引失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query DNS data"})
+		return
+	}
+
+	c.JSON(200, resolver.resolve(hostname))
+}
+
+// DNS 解析器：按主机名索引解析记录，按 IP/域名索引云资源
+type dnsResolver struct {
+	records   map[string][]database.DNSRecord // 主机名 → 解析记录
+	resources map[string][]DNSTarget          // IP 或域名 → 云资源
+}
+
+// 从数据库加载解析记录及 ECS、EIP、负载均衡、RDS、OSS、CDN 地址，构建解析器
+func newDNSResolver() (*dnsResolver, error) {
+	r := &dnsResolver{
+		records:   map[string][]database.DNSRecord{},
+		resources: map[string][]DNSTarget{},
+	}
+
+	dnsRecords, err := database.ListDNSRecords("")
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range dnsRecords {
+		r.records[rec.Hostname] = append(r.records[rec.Hostname], rec)
+	}
+
+	ecsRecords, err := database.ListECSRecords()
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range ecsRecords {
+		target := DNSTarget{ResourceType: "ecs", ResourceID: rec.InstanceID, Name: rec.InstanceName, CloudName: rec.CloudName}
+		r.addResource(rec.PublicIP, target)
+		r.addResource(rec.PrivateIP, target)
+	}
+
+	eipRecords, err := database.ListEIPRecords()
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range eipRecords {
+		target := DNSTarget{ResourceType: "eip", ResourceID: rec.AllocationID, Name: rec.Name, CloudName: rec.CloudName}
+		if rec.InstanceID != "" {
+			target.Detail = "绑定 " + rec.InstanceType + " " + rec.InstanceID
+		}
+		r.addResource(rec.IPAddress, target)
+	}
+
+	lbRecords, err := database.ListAllLoadBalancerRecords()
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range lbRecords {
+		target := DNSTarget{ResourceType: rec.LBType, ResourceID: rec.InstanceID, Name: rec.LoadBalancerName, CloudName: rec.CloudName}
+		r.addResource(rec.Addresses, target)
+		r.addResource(rec.DNSName, target)
+	}
+
+	rdsRecords, err := database.ListRDSRecords()
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range rdsRecords {
+		target := DNSTarget{ResourceType: "rds", ResourceID: rec.InstanceID, Name: rec.Description, CloudName: rec.CloudName}
+		r.addResource(rec.ConnectionString, target)
+	}
+
+	// OSS Bucket 域名为 "<Bucket>.<Endpoint>"
+	bucketRecords, err := database.ListOSSBucketRecords()
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range bucketRecords {
+		target := DNSTarget{ResourceType: "oss", ResourceID: rec.BucketName, Name: rec.BucketName, CloudName: rec.CloudName}
+		if rec.ExtranetEndpoint != "" {
+			r.addResource(rec.BucketName+"."+rec.ExtranetEndpoint, target)
+		}
+		if rec.IntranetEndpoint != "" {
+			r.addResource(rec.BucketName+"."+rec.IntranetEndpoint, target)
+		}
+	}
+
+	// 解析记录 CNAME 到 CDN 分配的域名时指向对应的加速域名
+	cdnRecords, err := database.ListCDNDomainRecords()
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range cdnRecords {
+		target := DNSTarget{ResourceType: rec.Product, ResourceID: rec.DomainName, Name: rec.DomainName, CloudName: rec.CloudName}
+		r.addResource(rec.CNAME, target)
+	}
+
+	return r, nil
+}
+
+// 将逗号分隔的地址列表登记到资源索引
+func (r *dnsResolver) addResource(addresses string, target DNSTarget) {
+	for _, addr := range splitIDs(addresses) {
+		key := normalizeHostname(addr)
+		r.resources[key] = append(r.resources[key], target)
+	}
+}
+
+// 解析主机名，CNAME 记录指向的主机名如果也在已同步的域名中，则继续展开
+func (r *dnsResolver) resolve(hostname string) DNSResolution {
+	resolution := DNSResolution{Hostname: hostname, Chain: []DNSChainStep{}}
+	visited := map[string]bool{}
+
+	var walk func(name string, depth int)
+	walk = func(name string, depth int) {
+		if visited[name] || depth > maxDNSChainDepth {
+			return
+		}
+		visited[name] = true
+
+		for _, rec := range r.records[name] {
+			value := normalizeHostname(rec.Value)
+			resolution.Chain = append(resolution.Chain, DNSChainStep{DNSRecord: rec, Targets: r.resources[value]})
+			if strings.EqualFold(rec.Type, "CNAME") {
+				walk(value, depth+1)
+			}
+		}
+	}
+	walk(hostname, 0)
+
+	return resolution
+}
+
+// 统一主机名格式：小写并去除末尾的点
+func normalizeHostname(name string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(name)), ".")
+}
@@ -0,0 +1,104 @@
+package api
+
+import (
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+	"github.com/WillemCode/AliCloud_Resources/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// 弹性伸缩组及其伸缩配置
+type ScalingGroupDetail struct {
+	database.ScalingGroupRecord
+	Configurations []database.ScalingConfigurationRecord
+	InstanceCount  int // 已同步的 ECS 实例数
+}
+
+// 处理弹性伸缩组列表请求
+func handleScalingGroupList(c *gin.Context) {
+	page, pageSize := getPaginationParams(c)
+
+	groups, err := database.ListScalingGroupRecords()
+	if err != nil {
+		logger.Log.Error("查询伸缩组数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query scaling group data"})
+		return
+	}
+	configurations, err := database.ListScalingConfigurations()
+	if err != nil {
+		logger.Log.Error("查询伸缩配置数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query scaling configuration data"})
+		return
+	}
+	ecsRecords, err := database.ListECSRecords()
+	if err != nil {
+		logger.Log.Error("查询 ECS 数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query ECS data"})
+		return
+	}
+	instanceCounts := map[string]int{}
+	for _, rec := range ecsRecords {
+		if rec.ScalingGroupID != "" {
+			instanceCounts[rec.ScalingGroupID]++
+		}
+	}
+
+	// 应用分页
+	total := len(groups)
+	paginatedData := applyPagination(groups, page, pageSize)
+
+	details := make([]ScalingGroupDetail, 0, len(paginatedData))
+	for _, rec := range paginatedData {
+		details = append(details, ScalingGroupDetail{
+			ScalingGroupRecord: rec,
+			Configurations:     configurations[rec.ScalingGroupID],
+			InstanceCount:      instanceCounts[rec.ScalingGroupID],
+		})
+	}
+
+	c.JSON(200, PaginatedResponse{
+		Data:     details,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// 处理伸缩组实例列表请求：返回属于该伸缩组的 ECS 实例
+func handleScalingGroupInstances(c *gin.Context) {
+	page, pageSize := getPaginationParams(c)
+	scalingGroupID := c.Param("id")
+
+	ecsRecords, err := database.ListECSRecords()
+	if err != nil {
+		logger.Log.Error("查询 ECS 数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query ECS data"})
+		return
+	}
+	instances := filterRecords(ecsRecords, func(r database.ECSRecord) bool { return r.ScalingGroupID == scalingGroupID })
+
+	c.JSON(200, PaginatedResponse{
+		Data:     applyPagination(instances, page, pageSize),
+		Total:    len(instances),
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// 处理 ECI 容器组列表请求
+func handleECIList(c *gin.Context) {
+	page, pageSize := getPaginationParams(c)
+
+	groups, err := database.ListECIContainerGroupRecords()
+	if err != nil {
+		logger.Log.Error("查询 ECI 数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query ECI data"})
+		return
+	}
+
+	c.JSON(200, PaginatedResponse{
+		Data:     applyPagination(groups, page, pageSize),
+		Total:    len(groups),
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
@@ -0,0 +1,118 @@
+package api
+
+import (
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+	"github.com/WillemCode/AliCloud_Resources/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// FC 服务及其函数数量
+type FCServiceDetail struct {
+	database.FCServiceRecord
+	FunctionCount int // 函数数量
+}
+
+// FC 函数及其所属服务、触发器
+type FCFunctionDetail struct {
+	database.FCFunctionRecord
+	ServiceName string
+	CloudName   string
+	RegionID    string
+	Triggers    []database.FCTriggerRecord
+}
+
+// 处理 FC 服务列表请求
+func handleFCServiceList(c *gin.Context) {
+	page, pageSize := getPaginationParams(c)
+
+	services, err := database.ListFCServiceRecords()
+	if err != nil {
+		logger.Log.Error("查询 FC 服务数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query FC service data"})
+		return
+	}
+	functions, err := database.ListFCFunctionRecords()
+	if err != nil {
+		logger.Log.Error("查询 FC 函数数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query FC function data"})
+		return
+	}
+	functionCounts := map[string]int{}
+	for _, rec := range functions {
+		functionCounts[rec.ServiceID]++
+	}
+
+	// 应用分页
+	total := len(services)
+	paginatedData := applyPagination(services, page, pageSize)
+
+	details := make([]FCServiceDetail, 0, len(paginatedData))
+	for _, rec := range paginatedData {
+		details = append(details, FCServiceDetail{FCServiceRecord: rec, FunctionCount: functionCounts[rec.ServiceID]})
+	}
+
+	c.JSON(200, PaginatedResponse{
+		Data:     details,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// 处理 FC 函数列表请求，支持 service=<服务ID> 及 runtime=<运行时> 筛选
+func handleFCFunctionList(c *gin.Context) {
+	page, pageSize := getPaginationParams(c)
+
+	services, err := database.ListFCServiceRecords()
+	if err != nil {
+		logger.Log.Error("查询 FC 服务数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query FC service data"})
+		return
+	}
+	functions, err := database.ListFCFunctionRecords()
+	if err != nil {
+		logger.Log.Error("查询 FC 函数数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query FC function data"})
+		return
+	}
+	triggers, err := database.ListFCTriggers()
+	if err != nil {
+		logger.Log.Error("查询 FC 触发器数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query FC trigger data"})
+		return
+	}
+
+	if serviceID := c.Query("service"); serviceID != "" {
+		functions = filterRecords(functions, func(r database.FCFunctionRecord) bool { return r.ServiceID == serviceID })
+	}
+	if runtime := c.Query("runtime"); runtime != "" {
+		functions = filterRecords(functions, func(r database.FCFunctionRecord) bool { return r.Runtime == runtime })
+	}
+	serviceByID := make(map[string]database.FCServiceRecord, len(services))
+	for _, rec := range services {
+		serviceByID[rec.ServiceID] = rec
+	}
+
+	// 应用分页
+	total := len(functions)
+	paginatedData := applyPagination(functions, page, pageSize)
+
+	details := make([]FCFunctionDetail, 0, len(paginatedData))
+	for _, rec := range paginatedData {
+		service := serviceByID[rec.ServiceID]
+		details = append(details, FCFunctionDetail{
+			FCFunctionRecord: rec,
+			ServiceName:      service.ServiceName,
+			CloudName:        service.CloudName,
+			RegionID:         service.RegionID,
+			Triggers:         triggers[rec.FunctionID],
+		})
+	}
+
+	c.JSON(200, PaginatedResponse{
+		Data:     details,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
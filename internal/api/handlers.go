@@ -1,6 +1,7 @@
 package api
 
 import (
+	"sort"
 	"strconv"
 	"strings"
 
@@ -37,7 +38,95 @@ func SetupRoutes(router *gin.Engine) {
 	router.GET("/slb", handleSLBList)
 	router.GET("/redis", handleRedisList)
 	router.GET("/polardb", handlePolarDBList)
+	router.GET("/mongodb", handleMongoDBList)
+	router.GET("/mq", handleMQList)
+	router.GET("/analytic", handleAnalyticList)
 	router.GET("/search", handleSearch)
+	router.GET("/stats", handleStats)
+	router.GET("/stats/history", handleStatsHistory)
+	router.GET("/diff", handleDiff)
+
+	// 安全组与暴露面分析
+	router.GET("/security-groups", handleSecurityGroupList)
+	router.GET("/security-groups/:id/rules", handleSecurityGroupRules)
+	router.GET("/exposure", handleExposure)
+
+	// VPC、交换机及 NAT 网关
+	router.GET("/vpcs", handleVPCList)
+	router.GET("/vpcs/:id/resources", handleVPCResources)
+	router.GET("/vswitches", handleVSwitchList)
+	router.GET("/vswitches/:id/resources", handleVSwitchResources)
+	router.GET("/nat-gateways", handleNatGatewayList)
+	router.GET("/nat-gateways/:id/entries", handleNatGatewayEntries)
+	router.GET("/network/cidr-overlaps", handleCidrOverlaps)
+	router.GET("/network/reachability", handleReachability)
+
+	// VPN 网关、云企业网及高速通道
+	router.GET("/vpn/gateways", handleVPNGatewayList)
+	router.GET("/vpn/customer-gateways", handleCustomerGatewayList)
+	router.GET("/cen", handleCENList)
+	router.GET("/express-connect/physical-connections", handlePhysicalConnectionList)
+	router.GET("/express-connect/vbrs", handleVBRList)
+
+	// ACK 集群、节点池及节点
+	router.GET("/ack/clusters", handleACKClusterList)
+	router.GET("/ack/clusters/:id/nodes", handleACKClusterNodes)
+
+	// OSS Bucket 及公开访问报表
+	router.GET("/oss", handleOSSList)
+	router.GET("/oss/public", handlePublicOSSBuckets)
+
+	// 弹性伸缩组及弹性容器实例
+	router.GET("/ess/scaling-groups", handleScalingGroupList)
+	router.GET("/ess/scaling-groups/:id/instances", handleScalingGroupInstances)
+	router.GET("/eci", handleECIList)
+
+	// 函数计算、日志服务及容器镜像服务
+	router.GET("/fc/services", handleFCServiceList)
+	router.GET("/fc/functions", handleFCFunctionList)
+	router.GET("/sls/projects", handleSLSProjectList)
+	router.GET("/acr/instances", handleACRInstanceList)
+	router.GET("/acr/repositories", handleACRRepositoryList)
+
+	// 云解析 DNS/PrivateZone 及主机名解析链
+	router.GET("/dns/zones", handleDNSZoneList)
+	router.GET("/dns/zones/:id/records", handleDNSZoneRecords)
+	router.GET("/dns/resolve", handleDNSResolve)
+
+	// CDN/DCDN 加速域名及源站
+	router.GET("/cdn", handleCDNList)
+
+	// SSL 证书及到期报表
+	router.GET("/certificates", handleCertificateList)
+	router.GET("/certificates/expiring", handleExpiringCertificates)
+
+	// RAM 用户、用户组、角色及 AccessKey 审计
+	router.GET("/ram/users", handleRAMUserList)
+	router.GET("/ram/groups", handleRAMGroupList)
+	router.GET("/ram/roles", handleRAMRoleList)
+	router.GET("/ram/access-keys", handleRAMAccessKeyList)
+	router.GET("/ram/access-keys/stale", handleStaleAccessKeys)
+
+	// 弹性公网 IP
+	router.GET("/eips", handleEIPList)
+	router.GET("/eips/unbound", handleUnboundEIPs)
+
+	// RDS 数据库、账号及白名单
+	router.GET("/rds/databases", handleRDSDatabaseList)
+	router.GET("/rds/:id/details", handleRDSDetail)
+
+	// 实例账单费用及费用排行
+	router.GET("/costs", handleCostList)
+	router.GET("/costs/top", handleTopCosts)
+
+	// 闲置及低负载资源报表
+	router.GET("/rightsizing", handleRightsizing)
+
+	// 负载均衡（CLB/ALB/NLB）监听及后端服务器
+	router.GET("/load-balancers", handleLoadBalancerList)
+	router.GET("/load-balancers/:id/listeners", handleLBListeners)
+	router.GET("/slb/:id/listeners", handleLBListeners)
+	router.GET("/ecs/:id/load-balancers", handleECSLoadBalancers)
 }
 
 // 处理 ECS 列表请求
@@ -50,6 +139,14 @@ func handleECSList(c *gin.Context) {
 		c.JSON(500, gin.H{"error": "failed to query ECS data"})
 		return
 	}
+	// 按伸缩组筛选：scaling_group 指定伸缩组ID，autoscaled=false 隐藏伸缩组内实例，autoscaled=true 只看伸缩组内实例
+	if scalingGroupID := c.Query("scaling_group"); scalingGroupID != "" {
+		ecsRecords = filterRecords(ecsRecords, func(r database.ECSRecord) bool { return r.ScalingGroupID == scalingGroupID })
+	}
+	if autoscaled := c.Query("autoscaled"); autoscaled != "" {
+		want := autoscaled == "true"
+		ecsRecords = filterRecords(ecsRecords, func(r database.ECSRecord) bool { return (r.ScalingGroupID != "") == want })
+	}
 
 	// 应用分页
 	total := len(ecsRecords)
@@ -109,6 +206,12 @@ func handleSLBList(c *gin.Context) {
 	})
 }
 
+// Tair Redis 实例及其连接地址
+type RedisDetail struct {
+	database.RedisRecord
+	Endpoints []database.RedisEndpointRecord
+}
+
 // 处理 Tair Redis 列表请求
 func handleRedisList(c *gin.Context) {
 	page, pageSize := getPaginationParams(c)
@@ -119,19 +222,38 @@ func handleRedisList(c *gin.Context) {
 		c.JSON(500, gin.H{"error": "failed to query SLB data"})
 		return
 	}
+	endpoints, err := database.ListRedisEndpoints()
+	if err != nil {
+		logger.Log.Error("查询 Tair Redis 连接地址数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query Redis endpoint data"})
+		return
+	}
 
 	// 应用分页
 	total := len(RedisRecords)
 	paginatedData := applyPagination(RedisRecords, page, pageSize)
 
+	// 为当前页的实例附加结构化连接地址
+	details := make([]RedisDetail, 0, len(paginatedData))
+	for _, rec := range paginatedData {
+		details = append(details, RedisDetail{RedisRecord: rec, Endpoints: endpoints[rec.InstanceID]})
+	}
+
 	c.JSON(200, PaginatedResponse{
-		Data:     paginatedData,
+		Data:     details,
 		Total:    total,
 		Page:     page,
 		PageSize: pageSize,
 	})
 }
 
+// PolarDB 集群及其节点、连接地址
+type PolarDBDetail struct {
+	database.PolarDBRecord
+	Nodes     []database.PolarDBNodeRecord
+	Endpoints []database.PolarDBEndpointRecord
+}
+
 // 处理 PolarDB 列表请求
 func handlePolarDBList(c *gin.Context) {
 	page, pageSize := getPaginationParams(c)
@@ -142,13 +264,35 @@ func handlePolarDBList(c *gin.Context) {
 		c.JSON(500, gin.H{"error": "failed to query PolarDB data"})
 		return
 	}
+	nodes, err := database.ListPolarDBNodes()
+	if err != nil {
+		logger.Log.Error("查询 PolarDB 节点数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query PolarDB node data"})
+		return
+	}
+	endpoints, err := database.ListPolarDBEndpoints()
+	if err != nil {
+		logger.Log.Error("查询 PolarDB 连接地址数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query PolarDB endpoint data"})
+		return
+	}
 
 	// 应用分页
 	total := len(polarDBRecords)
 	paginatedData := applyPagination(polarDBRecords, page, pageSize)
 
+	// 为当前页的集群附加节点及结构化连接地址
+	details := make([]PolarDBDetail, 0, len(paginatedData))
+	for _, rec := range paginatedData {
+		details = append(details, PolarDBDetail{
+			PolarDBRecord: rec,
+			Nodes:         nodes[rec.InstanceID],
+			Endpoints:     endpoints[rec.InstanceID],
+		})
+	}
+
 	c.JSON(200, PaginatedResponse{
-		Data:     paginatedData,
+		Data:     details,
 		Total:    total,
 		Page:     page,
 		PageSize: pageSize,
@@ -182,7 +326,7 @@ func handleSearch(c *gin.Context) {
 		}
 	}
 
-	if resourceType == "all" || resourceType == "rds" {
+	if resourceType == "all" || resourceType == "db" || resourceType == "rds" {
 		rdsRecords, err := database.ListRDSRecords()
 		if err == nil {
 			for _, record := range rdsRecords {
@@ -191,6 +335,15 @@ func handleSearch(c *gin.Context) {
 				}
 			}
 		}
+		// 按数据库名搜索 RDS 实例下的逻辑库
+		rdsDatabases, err := database.ListRDSDatabases("")
+		if err == nil {
+			for _, record := range rdsDatabases {
+				if containsKeyword(record, keyword) {
+					results = append(results, record)
+				}
+			}
+		}
 	}
 
 	if resourceType == "all" || resourceType == "slb" {
@@ -204,7 +357,22 @@ func handleSearch(c *gin.Context) {
 		}
 	}
 
-	if resourceType == "all" || resourceType == "polardb" {
+	// ALB/NLB 与 CLB 一同归入 slb 类型，也可通过 alb/nlb 单独筛选
+	if resourceType == "all" || resourceType == "slb" || resourceType == database.LBTypeALB || resourceType == database.LBTypeNLB {
+		lbRecords, err := database.ListLoadBalancerRecords()
+		if err == nil {
+			for _, record := range lbRecords {
+				if resourceType != "all" && resourceType != "slb" && resourceType != record.LBType {
+					continue
+				}
+				if containsKeyword(record, keyword) {
+					results = append(results, record)
+				}
+			}
+		}
+	}
+
+	if resourceType == "all" || resourceType == "db" || resourceType == "polardb" {
 		polarDBRecords, err := database.ListPolarDBRecords()
 		if err == nil {
 			for _, record := range polarDBRecords {
@@ -215,6 +383,259 @@ func handleSearch(c *gin.Context) {
 		}
 	}
 
+	if resourceType == "all" || resourceType == "db" || resourceType == "mongodb" {
+		mongoRecords, err := database.ListMongoDBRecords()
+		if err == nil {
+			for _, record := range mongoRecords {
+				if containsKeyword(record, keyword) {
+					results = append(results, record)
+				}
+			}
+		}
+	}
+
+	// 分析型数据库可按 analytic 或具体产品（elasticsearch/clickhouse/adb_mysql/adb_pg/lindorm）检索
+	if resourceType == "all" || resourceType == "db" || resourceType == "analytic" || isAnalyticProduct(resourceType) {
+		analyticRecords, err := database.ListAnalyticInstanceRecords()
+		if err == nil {
+			for _, record := range analyticRecords {
+				if isAnalyticProduct(resourceType) && record.Product != resourceType {
+					continue
+				}
+				if containsKeyword(record, keyword) {
+					results = append(results, record)
+				}
+			}
+		}
+	}
+
+	if resourceType == "all" || resourceType == "ack" {
+		clusterRecords, err := database.ListACKClusterRecords()
+		if err == nil {
+			for _, record := range clusterRecords {
+				if containsKeyword(record, keyword) {
+					results = append(results, record)
+				}
+			}
+		}
+	}
+
+	if resourceType == "all" || resourceType == "ess" {
+		scalingGroups, err := database.ListScalingGroupRecords()
+		if err == nil {
+			for _, record := range scalingGroups {
+				if containsKeyword(record, keyword) {
+					results = append(results, record)
+				}
+			}
+		}
+	}
+
+	if resourceType == "all" || resourceType == "eci" {
+		eciRecords, err := database.ListECIContainerGroupRecords()
+		if err == nil {
+			for _, record := range eciRecords {
+				if containsKeyword(record, keyword) {
+					results = append(results, record)
+				}
+			}
+		}
+	}
+
+	if resourceType == "all" || resourceType == "fc" {
+		serviceRecords, err := database.ListFCServiceRecords()
+		if err == nil {
+			for _, record := range serviceRecords {
+				if containsKeyword(record, keyword) {
+					results = append(results, record)
+				}
+			}
+		}
+		functionRecords, err := database.ListFCFunctionRecords()
+		if err == nil {
+			for _, record := range functionRecords {
+				if containsKeyword(record, keyword) {
+					results = append(results, record)
+				}
+			}
+		}
+	}
+
+	if resourceType == "all" || resourceType == "sls" {
+		projectRecords, err := database.ListSLSProjectRecords()
+		if err == nil {
+			logstores, _ := database.ListSLSLogstores()
+			for _, record := range projectRecords {
+				if containsKeyword(record, keyword) {
+					results = append(results, record)
+				}
+				for _, logstore := range logstores[record.ProjectName] {
+					if containsKeyword(logstore, keyword) {
+						results = append(results, logstore)
+					}
+				}
+			}
+		}
+	}
+
+	if resourceType == "all" || resourceType == "acr" {
+		instanceRecords, err := database.ListACRInstanceRecords()
+		if err == nil {
+			for _, record := range instanceRecords {
+				if containsKeyword(record, keyword) {
+					results = append(results, record)
+				}
+			}
+		}
+		repositoryRecords, err := database.ListACRRepositoryRecords()
+		if err == nil {
+			for _, record := range repositoryRecords {
+				if containsKeyword(record, keyword) {
+					results = append(results, record)
+				}
+			}
+		}
+	}
+
+	if resourceType == "all" || resourceType == "oss" {
+		bucketRecords, err := database.ListOSSBucketRecords()
+		if err == nil {
+			for _, record := range bucketRecords {
+				if containsKeyword(record, keyword) {
+					results = append(results, record)
+				}
+			}
+		}
+	}
+
+	// DNS 记录按主机名或记录值检索，每个命中的主机名返回完整解析链
+	if resourceType == "all" || resourceType == "dns" {
+		resolver, err := newDNSResolver()
+		if err == nil {
+			var hostnames []string
+			seen := map[string]bool{}
+			for hostname, records := range resolver.records {
+				for _, record := range records {
+					if !seen[hostname] && containsKeyword(record, keyword) {
+						seen[hostname] = true
+						hostnames = append(hostnames, hostname)
+					}
+				}
+			}
+			sort.Strings(hostnames)
+			for _, hostname := range hostnames {
+				results = append(results, resolver.resolve(hostname))
+			}
+		}
+	}
+
+	// 消息队列实例按接入点检索，Topic/Group 按名称检索
+	if resourceType == "all" || resourceType == "mq" {
+		mqRecords, err := database.ListMQInstanceRecords()
+		if err == nil {
+			for _, record := range mqRecords {
+				if containsKeyword(record, keyword) {
+					results = append(results, record)
+				}
+			}
+		}
+		mqResources, err := database.ListMQResources("")
+		if err == nil {
+			for _, record := range mqResources {
+				if containsKeyword(record, keyword) {
+					results = append(results, record)
+				}
+			}
+		}
+	}
+
+	if resourceType == "all" || resourceType == "certificate" {
+		certRecords, err := database.ListCertificateRecords()
+		if err == nil {
+			for _, record := range certRecords {
+				if containsKeyword(record, keyword) {
+					results = append(results, record)
+				}
+			}
+		}
+	}
+
+	// 加速域名按域名或 CNAME 检索，源站按地址检索
+	if resourceType == "all" || resourceType == "cdn" {
+		cdnRecords, err := database.ListCDNDomainRecords()
+		if err == nil {
+			origins, _ := database.ListCDNOrigins()
+			for _, record := range cdnRecords {
+				if containsKeyword(record, keyword) {
+					results = append(results, record)
+				}
+				for _, origin := range origins[record.DomainName] {
+					if containsKeyword(origin, keyword) {
+						results = append(results, origin)
+					}
+				}
+			}
+		}
+	}
+
+	// RAM 用户按用户名检索，AccessKey 按 ID 检索，便于定位泄露的 AccessKey 属于哪个账户
+	if resourceType == "all" || resourceType == "ram" {
+		userRecords, err := database.ListRAMUserRecords()
+		if err == nil {
+			for _, record := range userRecords {
+				if containsKeyword(record, keyword) {
+					results = append(results, record)
+				}
+			}
+		}
+		roleRecords, err := database.ListRAMRoleRecords()
+		if err == nil {
+			for _, record := range roleRecords {
+				if containsKeyword(record, keyword) {
+					results = append(results, record)
+				}
+			}
+		}
+		keyRecords, err := database.ListRAMAccessKeyRecords()
+		if err == nil {
+			for _, record := range keyRecords {
+				if containsKeyword(record, keyword) {
+					results = append(results, record)
+				}
+			}
+		}
+	}
+
+	if resourceType == "all" || resourceType == "eip" {
+		eipRecords, err := database.ListEIPRecords()
+		if err == nil {
+			for _, record := range eipRecords {
+				if containsKeyword(record, keyword) {
+					results = append(results, record)
+				}
+			}
+		}
+	}
+
+	if resourceType == "all" || resourceType == "vpn" {
+		gatewayRecords, err := database.ListVPNGatewayRecords()
+		if err == nil {
+			for _, record := range gatewayRecords {
+				if containsKeyword(record, keyword) {
+					results = append(results, record)
+				}
+			}
+		}
+		customerGatewayRecords, err := database.ListCustomerGatewayRecords()
+		if err == nil {
+			for _, record := range customerGatewayRecords {
+				if containsKeyword(record, keyword) {
+					results = append(results, record)
+				}
+			}
+		}
+	}
+
 	page, pageSize := getPaginationParams(c)
 	total := len(results)
 	paginatedResults := applyPagination(results, page, pageSize)
@@ -250,60 +671,13 @@ func getPaginationParams(c *gin.Context) (int, int) {
 	return page, pageSize
 }
 
-// 应用分页到任意切片
-func applyPagination(data interface{}, page, pageSize int) interface{} {
-	switch v := data.(type) {
-	case []database.ECSRecord:
-		return paginateSlice(v, page, pageSize)
-	case []database.RDSRecord:
-		return paginateSlice(v, page, pageSize)
-	case []database.SLBRecord:
-		return paginateSlice(v, page, pageSize)
-	case []database.PolarDBRecord:
-		return paginateSlice(v, page, pageSize)
-	case []interface{}:
-		return paginateSlice(v, page, pageSize)
-	default:
-		return data
-	}
-}
-
-// 对任意切片进行分页
-func paginateSlice(slice interface{}, page, pageSize int) interface{} {
-	switch v := slice.(type) {
-	case []database.ECSRecord:
-		start, end := calculatePaginationBounds(len(v), page, pageSize)
-		if start >= len(v) {
-			return []database.ECSRecord{}
-		}
-		return v[start:end]
-	case []database.RDSRecord:
-		start, end := calculatePaginationBounds(len(v), page, pageSize)
-		if start >= len(v) {
-			return []database.RDSRecord{}
-		}
-		return v[start:end]
-	case []database.SLBRecord:
-		start, end := calculatePaginationBounds(len(v), page, pageSize)
-		if start >= len(v) {
-			return []database.SLBRecord{}
-		}
-		return v[start:end]
-	case []database.PolarDBRecord:
-		start, end := calculatePaginationBounds(len(v), page, pageSize)
-		if start >= len(v) {
-			return []database.PolarDBRecord{}
-		}
-		return v[start:end]
-	case []interface{}:
-		start, end := calculatePaginationBounds(len(v), page, pageSize)
-		if start >= len(v) {
-			return []interface{}{}
-		}
-		return v[start:end]
-	default:
-		return slice
+// 对任意类型的记录切片进行分页
+func applyPagination[T any](data []T, page, pageSize int) []T {
+	start, end := calculatePaginationBounds(len(data), page, pageSize)
+	if start >= len(data) {
+		return []T{}
 	}
+	return data[start:end]
 }
 
 // 计算分页的起始和结束索引
@@ -326,19 +700,134 @@ func containsKeyword(record interface{}, keyword string) bool {
 			strings.Contains(strings.ToLower(v.PublicIP), keyword) ||
 			strings.Contains(strings.ToLower(v.OSName), keyword) ||
 			strings.Contains(strings.ToLower(v.PrivateIP), keyword) ||
-			strings.Contains(strings.ToLower(v.RegionID), keyword)
+			strings.Contains(strings.ToLower(v.RegionID), keyword) ||
+			strings.Contains(strings.ToLower(v.ClusterID), keyword) ||
+			strings.Contains(strings.ToLower(v.ClusterName), keyword) ||
+			strings.Contains(strings.ToLower(v.NodePoolName), keyword) ||
+			strings.Contains(strings.ToLower(v.ScalingGroupName), keyword)
+	case database.ScalingGroupRecord:
+		return strings.Contains(strings.ToLower(v.ScalingGroupID), keyword) ||
+			strings.Contains(strings.ToLower(v.Name), keyword) ||
+			strings.Contains(strings.ToLower(v.LoadBalancerIDs), keyword) ||
+			strings.Contains(strings.ToLower(v.RegionID), keyword) ||
+			strings.Contains(strings.ToLower(v.CloudName), keyword)
+	case database.ECIContainerGroupRecord:
+		return strings.Contains(strings.ToLower(v.ContainerGroupID), keyword) ||
+			strings.Contains(strings.ToLower(v.Name), keyword) ||
+			strings.Contains(strings.ToLower(v.IntranetIP), keyword) ||
+			strings.Contains(strings.ToLower(v.InternetIP), keyword) ||
+			strings.Contains(strings.ToLower(v.Containers), keyword) ||
+			strings.Contains(strings.ToLower(v.RegionID), keyword) ||
+			strings.Contains(strings.ToLower(v.CloudName), keyword)
+	case database.ACKClusterRecord:
+		return strings.Contains(strings.ToLower(v.ClusterID), keyword) ||
+			strings.Contains(strings.ToLower(v.Name), keyword) ||
+			strings.Contains(strings.ToLower(v.Version), keyword) ||
+			strings.Contains(strings.ToLower(v.APIServerEndpoint), keyword) ||
+			strings.Contains(strings.ToLower(v.IntranetAPIServerEndpoint), keyword) ||
+			strings.Contains(strings.ToLower(v.RegionID), keyword) ||
+			strings.Contains(strings.ToLower(v.CloudName), keyword)
+	case database.OSSBucketRecord:
+		return strings.Contains(strings.ToLower(v.BucketName), keyword) ||
+			strings.Contains(strings.ToLower(v.ExtranetEndpoint), keyword) ||
+			strings.Contains(strings.ToLower(v.RegionID), keyword) ||
+			strings.Contains(strings.ToLower(v.CloudName), keyword)
+	case database.CertificateRecord:
+		return strings.Contains(strings.ToLower(v.CertID), keyword) ||
+			strings.Contains(strings.ToLower(v.Name), keyword) ||
+			strings.Contains(strings.ToLower(v.CommonName), keyword) ||
+			strings.Contains(strings.ToLower(v.SANs), keyword) ||
+			strings.Contains(strings.ToLower(v.Issuer), keyword) ||
+			strings.Contains(strings.ToLower(v.CloudName), keyword)
+	case database.RAMUserRecord:
+		return strings.Contains(strings.ToLower(v.UserName), keyword) ||
+			strings.Contains(strings.ToLower(v.DisplayName), keyword) ||
+			strings.Contains(strings.ToLower(v.Comments), keyword)
+	case database.RAMRoleRecord:
+		return strings.Contains(strings.ToLower(v.RoleName), keyword) ||
+			strings.Contains(strings.ToLower(v.Arn), keyword)
+	case database.RAMAccessKeyRecord:
+		return strings.Contains(strings.ToLower(v.AccessKeyID), keyword)
+	case database.FCServiceRecord:
+		return strings.Contains(strings.ToLower(v.ServiceID), keyword) ||
+			strings.Contains(strings.ToLower(v.ServiceName), keyword) ||
+			strings.Contains(strings.ToLower(v.RegionID), keyword) ||
+			strings.Contains(strings.ToLower(v.CloudName), keyword)
+	case database.FCFunctionRecord:
+		return strings.Contains(strings.ToLower(v.FunctionID), keyword) ||
+			strings.Contains(strings.ToLower(v.FunctionName), keyword) ||
+			strings.Contains(strings.ToLower(v.Runtime), keyword)
+	case database.SLSProjectRecord:
+		return strings.Contains(strings.ToLower(v.ProjectName), keyword) ||
+			strings.Contains(strings.ToLower(v.Description), keyword) ||
+			strings.Contains(strings.ToLower(v.RegionID), keyword) ||
+			strings.Contains(strings.ToLower(v.CloudName), keyword)
+	case database.SLSLogstoreRecord:
+		return strings.Contains(strings.ToLower(v.LogstoreName), keyword)
+	case database.ACRInstanceRecord:
+		return strings.Contains(strings.ToLower(v.InstanceID), keyword) ||
+			strings.Contains(strings.ToLower(v.InstanceName), keyword) ||
+			strings.Contains(strings.ToLower(v.RegionID), keyword) ||
+			strings.Contains(strings.ToLower(v.CloudName), keyword)
+	case database.ACRRepositoryRecord:
+		return strings.Contains(strings.ToLower(v.NamespaceName+"/"+v.RepoName), keyword) ||
+			strings.Contains(strings.ToLower(v.Summary), keyword)
+	case database.CDNDomainRecord:
+		return strings.Contains(strings.ToLower(v.DomainName), keyword) ||
+			strings.Contains(strings.ToLower(v.CNAME), keyword) ||
+			strings.Contains(strings.ToLower(v.CloudName), keyword)
+	case database.CDNOriginRecord:
+		return strings.Contains(strings.ToLower(v.Content), keyword)
+	case database.DNSRecord:
+		return strings.Contains(v.Hostname, keyword) ||
+			strings.Contains(strings.ToLower(v.Value), keyword)
 	case database.RDSRecord:
 		return strings.Contains(strings.ToLower(v.InstanceID), keyword) ||
 			strings.Contains(strings.ToLower(v.Engine), keyword) ||
+			strings.Contains(strings.ToLower(v.EngineVersion), keyword) ||
 			strings.Contains(strings.ToLower(v.ConnectionString), keyword) ||
 			strings.Contains(strings.ToLower(v.RegionID), keyword) ||
 			strings.Contains(strings.ToLower(v.CloudName), keyword)
+	case database.AnalyticInstanceRecord:
+		return strings.Contains(strings.ToLower(v.InstanceID), keyword) ||
+			strings.Contains(strings.ToLower(v.Description), keyword) ||
+			strings.Contains(strings.ToLower(v.Engine), keyword) ||
+			strings.Contains(strings.ToLower(v.EngineVersion), keyword) ||
+			strings.Contains(strings.ToLower(v.ConnectionString), keyword) ||
+			strings.Contains(strings.ToLower(v.RegionID), keyword) ||
+			strings.Contains(strings.ToLower(v.CloudName), keyword)
+	case database.RDSDatabaseRecord:
+		return strings.Contains(strings.ToLower(v.DBName), keyword) ||
+			strings.Contains(strings.ToLower(v.Description), keyword)
 	case database.SLBRecord:
 		return strings.Contains(strings.ToLower(v.InstanceID), keyword) ||
 			strings.Contains(strings.ToLower(v.LoadBalancerName), keyword) ||
 			strings.Contains(strings.ToLower(v.IPAddress), keyword) ||
 			strings.Contains(strings.ToLower(v.RegionID), keyword) ||
 			strings.Contains(strings.ToLower(v.CloudName), keyword)
+	case database.LoadBalancerRecord:
+		return strings.Contains(strings.ToLower(v.InstanceID), keyword) ||
+			strings.Contains(strings.ToLower(v.LoadBalancerName), keyword) ||
+			strings.Contains(strings.ToLower(v.DNSName), keyword) ||
+			strings.Contains(strings.ToLower(v.Addresses), keyword) ||
+			strings.Contains(strings.ToLower(v.RegionID), keyword) ||
+			strings.Contains(strings.ToLower(v.CloudName), keyword)
+	case database.MongoDBRecord:
+		return strings.Contains(strings.ToLower(v.InstanceID), keyword) ||
+			strings.Contains(strings.ToLower(v.Description), keyword) ||
+			strings.Contains(strings.ToLower(v.EngineVersion), keyword) ||
+			strings.Contains(strings.ToLower(v.ConnectionString), keyword) ||
+			strings.Contains(strings.ToLower(v.RegionID), keyword) ||
+			strings.Contains(strings.ToLower(v.CloudName), keyword)
+	case database.MQInstanceRecord:
+		return strings.Contains(strings.ToLower(v.InstanceID), keyword) ||
+			strings.Contains(strings.ToLower(v.InstanceName), keyword) ||
+			strings.Contains(strings.ToLower(v.Endpoints), keyword) ||
+			strings.Contains(strings.ToLower(v.RegionID), keyword) ||
+			strings.Contains(strings.ToLower(v.CloudName), keyword)
+	case database.MQResourceRecord:
+		return strings.Contains(strings.ToLower(v.Name), keyword) ||
+			strings.Contains(strings.ToLower(v.Remark), keyword)
 	case database.PolarDBRecord:
 		return strings.Contains(strings.ToLower(v.InstanceID), keyword) ||
 			strings.Contains(strings.ToLower(v.Status), keyword) ||
@@ -346,6 +835,24 @@ func containsKeyword(record interface{}, keyword string) bool {
 			strings.Contains(strings.ToLower(v.ConnectionString), keyword) ||
 			strings.Contains(strings.ToLower(v.RegionID), keyword) ||
 			strings.Contains(strings.ToLower(v.CloudName), keyword)
+	case database.EIPRecord:
+		return strings.Contains(strings.ToLower(v.AllocationID), keyword) ||
+			strings.Contains(strings.ToLower(v.IPAddress), keyword) ||
+			strings.Contains(strings.ToLower(v.Name), keyword) ||
+			strings.Contains(strings.ToLower(v.InstanceID), keyword) ||
+			strings.Contains(strings.ToLower(v.RegionID), keyword) ||
+			strings.Contains(strings.ToLower(v.CloudName), keyword)
+	case database.VPNGatewayRecord:
+		return strings.Contains(strings.ToLower(v.VPNGatewayID), keyword) ||
+			strings.Contains(strings.ToLower(v.Name), keyword) ||
+			strings.Contains(strings.ToLower(v.InternetIP), keyword) ||
+			strings.Contains(strings.ToLower(v.VPCID), keyword) ||
+			strings.Contains(strings.ToLower(v.CloudName), keyword)
+	case database.CustomerGatewayRecord:
+		return strings.Contains(strings.ToLower(v.CustomerGatewayID), keyword) ||
+			strings.Contains(strings.ToLower(v.Name), keyword) ||
+			strings.Contains(strings.ToLower(v.IPAddress), keyword) ||
+			strings.Contains(strings.ToLower(v.CloudName), keyword)
 	default:
 		return false
 	}
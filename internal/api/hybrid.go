@@ -0,0 +1,386 @@
+package api
+
+import (
+	"sort"
+
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+	"github.com/WillemCode/AliCloud_Resources/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// IPsec 连接及其对端用户网关
+type VPNConnectionDetail struct {
+	database.VPNConnectionRecord
+	CustomerGateway *database.CustomerGatewayRecord `json:",omitempty"`
+}
+
+// VPN 网关及其 IPsec 连接
+type VPNGatewayDetail struct {
+	database.VPNGatewayRecord
+	Connections []VPNConnectionDetail
+}
+
+// 云企业网实例及其已加载网络实例、带宽包
+type CENDetail struct {
+	database.CENRecord
+	ChildInstances    []database.CENChildInstanceRecord
+	BandwidthPackages []database.CENBandwidthPackageRecord
+}
+
+// 物理专线及其边界路由器
+type PhysicalConnectionDetail struct {
+	database.PhysicalConnectionRecord
+	VBRs []database.VBRRecord
+}
+
+// 可达路径：从某个 VPC 出发可以访问的目标网络
+type ReachablePath struct {
+	TargetType string // 目标类型（VPC/IDC）
+	TargetID   string // 目标 VPC ID、边界路由器ID或用户网关ID
+	TargetName string
+	CloudName  string // 目标所属账户，跨账号加载且未同步时为空
+	RegionID   string
+	CidrBlock  string // 目标网段：VPC 网段、IPsec 对端网段或专线客户侧互联 IP
+	Via        string // 互通方式（CEN/VPN），经边界路由器的专线路径归入 CEN
+	ViaID      string // 云企业网实例ID或 IPsec 连接ID
+}
+
+// 单个 VPC 的可达性
+type VPCReachability struct {
+	VPC       database.VPCRecord
+	Reachable []ReachablePath
+}
+
+// 处理 VPN 网关列表请求，支持 vpc_id=<VPC ID> 筛选
+func handleVPNGatewayList(c *gin.Context) {
+	page, pageSize := getPaginationParams(c)
+
+	gateways, err := database.ListVPNGatewayRecords()
+	if err != nil {
+		logger.Log.Error("查询 VPN 网关数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query VPN gateway data"})
+		return
+	}
+	connections, err := database.ListVPNConnectionRecords()
+	if err != nil {
+		logger.Log.Error("查询 IPsec 连接数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query VPN connection data"})
+		return
+	}
+	customerGateways, err := database.ListCustomerGatewayRecords()
+	if err != nil {
+		logger.Log.Error("查询用户网关数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query customer gateway data"})
+		return
+	}
+	if vpcID := c.Query("vpc_id"); vpcID != "" {
+		gateways = filterRecords(gateways, func(r database.VPNGatewayRecord) bool { return r.VPCID == vpcID })
+	}
+
+	connectionsByGateway := groupVPNConnections(connections, customerGateways)
+
+	// 应用分页
+	total := len(gateways)
+	paginatedData := applyPagination(gateways, page, pageSize)
+
+	details := make([]VPNGatewayDetail, 0, len(paginatedData))
+	for _, rec := range paginatedData {
+		details = append(details, VPNGatewayDetail{
+			VPNGatewayRecord: rec,
+			Connections:      connectionsByGateway[rec.VPNGatewayID],
+		})
+	}
+
+	c.JSON(200, PaginatedResponse{
+		Data:     details,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// 处理用户网关列表请求
+func handleCustomerGatewayList(c *gin.Context) {
+	page, pageSize := getPaginationParams(c)
+
+	customerGateways, err := database.ListCustomerGatewayRecords()
+	if err != nil {
+		logger.Log.Error("查询用户网关数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query customer gateway data"})
+		return
+	}
+
+	c.JSON(200, PaginatedResponse{
+		Data:     applyPagination(customerGateways, page, pageSize),
+		Total:    len(customerGateways),
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// 处理云企业网列表请求
+func handleCENList(c *gin.Context) {
+	page, pageSize := getPaginationParams(c)
+
+	cens, err := database.ListCENRecords()
+	if err != nil {
+		logger.Log.Error("查询云企业网数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query CEN data"})
+		return
+	}
+	children, err := database.ListCENChildInstances()
+	if err != nil {
+		logger.Log.Error("查询云企业网网络实例数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query CEN child instance data"})
+		return
+	}
+	packages, err := database.ListCENBandwidthPackageRecords()
+	if err != nil {
+		logger.Log.Error("查询云企业网带宽包数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query CEN bandwidth package data"})
+		return
+	}
+
+	// 应用分页
+	total := len(cens)
+	paginatedData := applyPagination(cens, page, pageSize)
+
+	details := make([]CENDetail, 0, len(paginatedData))
+	for _, rec := range paginatedData {
+		details = append(details, CENDetail{
+			CENRecord:         rec,
+			ChildInstances:    children[rec.CENID],
+			BandwidthPackages: filterRecords(packages, func(r database.CENBandwidthPackageRecord) bool { return containsID(r.CENIDs, rec.CENID) }),
+		})
+	}
+
+	c.JSON(200, PaginatedResponse{
+		Data:     details,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// 处理物理专线列表请求
+func handlePhysicalConnectionList(c *gin.Context) {
+	page, pageSize := getPaginationParams(c)
+
+	connections, err := database.ListPhysicalConnectionRecords()
+	if err != nil {
+		logger.Log.Error("查询物理专线数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query physical connection data"})
+		return
+	}
+	vbrs, err := database.ListVBRRecords()
+	if err != nil {
+		logger.Log.Error("查询边界路由器数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query VBR data"})
+		return
+	}
+
+	// 应用分页
+	total := len(connections)
+	paginatedData := applyPagination(connections, page, pageSize)
+
+	details := make([]PhysicalConnectionDetail, 0, len(paginatedData))
+	for _, rec := range paginatedData {
+		details = append(details, PhysicalConnectionDetail{
+			PhysicalConnectionRecord: rec,
+			VBRs:                     filterRecords(vbrs, func(r database.VBRRecord) bool { return r.PhysicalConnectionID == rec.PhysicalConnectionID }),
+		})
+	}
+
+	c.JSON(200, PaginatedResponse{
+		Data:     details,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// 处理边界路由器列表请求，支持 physical_connection=<物理专线ID> 筛选
+func handleVBRList(c *gin.Context) {
+	page, pageSize := getPaginationParams(c)
+
+	vbrs, err := database.ListVBRRecords()
+	if err != nil {
+		logger.Log.Error("查询边界路由器数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query VBR data"})
+		return
+	}
+	if physicalConnectionID := c.Query("physical_connection"); physicalConnectionID != "" {
+		vbrs = filterRecords(vbrs, func(r database.VBRRecord) bool { return r.PhysicalConnectionID == physicalConnectionID })
+	}
+
+	c.JSON(200, PaginatedResponse{
+		Data:     applyPagination(vbrs, page, pageSize),
+		Total:    len(vbrs),
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// 处理 VPC 可达性请求：同一云企业网内已加载的 VPC 互相可达，边界路由器及 VPN 网关将 VPC 连通至本地 IDC
+// 支持 vpc_id=<VPC ID> 查询单个 VPC，默认返回全部 VPC
+func handleReachability(c *gin.Context) {
+	page, pageSize := getPaginationParams(c)
+
+	vpcs, err := database.ListVPCRecords()
+	if err != nil {
+		logger.Log.Error("查询 VPC 数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query VPC data"})
+		return
+	}
+	children, err := database.ListCENChildInstances()
+	if err != nil {
+		logger.Log.Error("查询云企业网网络实例数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query CEN child instance data"})
+		return
+	}
+	vbrs, err := database.ListVBRRecords()
+	if err != nil {
+		logger.Log.Error("查询边界路由器数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query VBR data"})
+		return
+	}
+	gateways, err := database.ListVPNGatewayRecords()
+	if err != nil {
+		logger.Log.Error("查询 VPN 网关数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query VPN gateway data"})
+		return
+	}
+	connections, err := database.ListVPNConnectionRecords()
+	if err != nil {
+		logger.Log.Error("查询 IPsec 连接数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query VPN connection data"})
+		return
+	}
+	customerGateways, err := database.ListCustomerGatewayRecords()
+	if err != nil {
+		logger.Log.Error("查询用户网关数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query customer gateway data"})
+		return
+	}
+
+	vpcByID := map[string]database.VPCRecord{}
+	for _, v := range vpcs {
+		vpcByID[v.VPCID] = v
+	}
+	vbrByID := map[string]database.VBRRecord{}
+	for _, v := range vbrs {
+		vbrByID[v.VBRID] = v
+	}
+	connectionsByGateway := groupVPNConnections(connections, customerGateways)
+	cenIDs := make([]string, 0, len(children))
+	for cenID := range children {
+		cenIDs = append(cenIDs, cenID)
+	}
+	sort.Strings(cenIDs)
+
+	if vpcID := c.Query("vpc_id"); vpcID != "" {
+		vpcs = filterRecords(vpcs, func(r database.VPCRecord) bool { return r.VPCID == vpcID })
+	}
+
+	// 只为当前页的 VPC 计算可达网络
+	results := make([]VPCReachability, 0, pageSize)
+	for _, v := range applyPagination(vpcs, page, pageSize) {
+		reachable := []ReachablePath{}
+
+		// 通过云企业网互通：与本 VPC 加载在同一 CEN 的其他网络实例
+		for _, cenID := range cenIDs {
+			members := children[cenID]
+			if !cenAttached(members, v.VPCID) {
+				continue
+			}
+			for _, member := range members {
+				if member.ChildInstanceID == v.VPCID || member.Status != "Attached" {
+					continue
+				}
+				path := ReachablePath{
+					TargetID: member.ChildInstanceID,
+					RegionID: member.ChildInstanceRegionID,
+					Via:      "CEN",
+					ViaID:    cenID,
+				}
+				switch member.ChildInstanceType {
+				case database.CENChildVPC:
+					path.TargetType = "VPC"
+					if peer, ok := vpcByID[member.ChildInstanceID]; ok {
+						path.TargetName = peer.VPCName
+						path.CloudName = peer.CloudName
+						path.CidrBlock = peer.CidrBlock
+					}
+				default:
+					// 边界路由器及云连接网均连通至本地 IDC
+					path.TargetType = "IDC"
+					if vbr, ok := vbrByID[member.ChildInstanceID]; ok {
+						path.TargetName = vbr.Name
+						path.CloudName = vbr.CloudName
+						path.CidrBlock = vbr.PeerGatewayIP
+					}
+				}
+				reachable = append(reachable, path)
+			}
+		}
+
+		// 通过 VPN 网关的 IPsec 连接互通至本地 IDC
+		for _, gw := range gateways {
+			if gw.VPCID != v.VPCID {
+				continue
+			}
+			for _, conn := range connectionsByGateway[gw.VPNGatewayID] {
+				path := ReachablePath{
+					TargetType: "IDC",
+					TargetID:   conn.CustomerGatewayID,
+					CloudName:  conn.CloudName,
+					RegionID:   conn.RegionID,
+					CidrBlock:  conn.RemoteSubnet,
+					Via:        "VPN",
+					ViaID:      conn.VPNConnectionID,
+				}
+				if conn.CustomerGateway != nil {
+					path.TargetName = conn.CustomerGateway.Name
+				}
+				reachable = append(reachable, path)
+			}
+		}
+
+		results = append(results, VPCReachability{VPC: v, Reachable: reachable})
+	}
+
+	c.JSON(200, PaginatedResponse{
+		Data:     results,
+		Total:    len(vpcs),
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// 按 VPN 网关分组 IPsec 连接，并关联对端用户网关
+func groupVPNConnections(connections []database.VPNConnectionRecord, customerGateways []database.CustomerGatewayRecord) map[string][]VPNConnectionDetail {
+	gatewayByID := map[string]database.CustomerGatewayRecord{}
+	for _, gw := range customerGateways {
+		gatewayByID[gw.CustomerGatewayID] = gw
+	}
+
+	results := map[string][]VPNConnectionDetail{}
+	for _, conn := range connections {
+		detail := VPNConnectionDetail{VPNConnectionRecord: conn}
+		if gw, ok := gatewayByID[conn.CustomerGatewayID]; ok {
+			detail.CustomerGateway = &gw
+		}
+		results[conn.VPNGatewayID] = append(results[conn.VPNGatewayID], detail)
+	}
+	return results
+}
+
+// 判断网络实例是否已加载到该云企业网
+func cenAttached(members []database.CENChildInstanceRecord, childInstanceID string) bool {
+	for _, member := range members {
+		if member.ChildInstanceID == childInstanceID && member.Status == "Attached" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,108 @@
+package api
+
+import (
+	"strings"
+
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+	"github.com/WillemCode/AliCloud_Resources/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// 监听及其转发规则、后端服务器
+type LBListenerDetail struct {
+	database.LBListenerRecord
+	Rules          []database.LBRuleRecord `json:",omitempty"`
+	BackendServers []database.LBBackendServerRecord
+}
+
+// 处理负载均衡列表请求：CLB、ALB、NLB 合并展示，可通过 type 参数筛选
+func handleLoadBalancerList(c *gin.Context) {
+	page, pageSize := getPaginationParams(c)
+
+	lbs, err := database.ListAllLoadBalancerRecords()
+	if err != nil {
+		logger.Log.Error("查询负载均衡数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query load balancer data"})
+		return
+	}
+	if lbType := c.Query("type"); lbType != "" {
+		lbs = filterRecords(lbs, func(r database.LoadBalancerRecord) bool { return r.LBType == lbType })
+	}
+
+	c.JSON(200, PaginatedResponse{
+		Data:     applyPagination(lbs, page, pageSize),
+		Total:    len(lbs),
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// 处理单个负载均衡实例监听列表请求，每个监听附带转发规则及其服务器组内的后端服务器和权重
+func handleLBListeners(c *gin.Context) {
+	listeners, err := database.ListLBListeners(c.Param("id"))
+	if err != nil {
+		logger.Log.Error("查询负载均衡监听失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query listeners"})
+		return
+	}
+
+	results := []LBListenerDetail{}
+	for _, l := range listeners {
+		detail := LBListenerDetail{LBListenerRecord: l, BackendServers: []database.LBBackendServerRecord{}}
+
+		rules, err := database.ListLBRules(l.ListenerID)
+		if err != nil {
+			logger.Log.Error("查询转发规则失败: ", err)
+			c.JSON(500, gin.H{"error": "failed to query forwarding rules"})
+			return
+		}
+		detail.Rules = rules
+
+		// 默认动作及转发规则引用的服务器组去重后汇总后端服务器
+		groupIDs := splitIDs(l.ServerGroupID)
+		for _, r := range rules {
+			groupIDs = append(groupIDs, splitIDs(r.ServerGroupIDs)...)
+		}
+		seen := map[string]bool{}
+		for _, groupID := range groupIDs {
+			if seen[groupID] {
+				continue
+			}
+			seen[groupID] = true
+			servers, err := database.ListLBBackendServers(groupID)
+			if err != nil {
+				logger.Log.Error("查询后端服务器失败: ", err)
+				c.JSON(500, gin.H{"error": "failed to query backend servers"})
+				return
+			}
+			detail.BackendServers = append(detail.BackendServers, servers...)
+		}
+		results = append(results, detail)
+	}
+	c.JSON(200, gin.H{"data": results})
+}
+
+// 处理 ECS 反查请求：列出将该实例作为后端服务器的负载均衡监听
+func handleECSLoadBalancers(c *gin.Context) {
+	mappings, err := database.ListLBMappingsByServer(c.Param("id"))
+	if err != nil {
+		logger.Log.Error("查询负载均衡反查数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query load balancer mappings"})
+		return
+	}
+	if mappings == nil {
+		mappings = []database.LBBackendMappingRecord{}
+	}
+	c.JSON(200, gin.H{"data": mappings})
+}
+
+// 拆分逗号分隔的 ID 列表，忽略空项
+func splitIDs(list string) []string {
+	var ids []string
+	for _, id := range strings.Split(list, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,48 @@
+package api
+
+import (
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+	"github.com/WillemCode/AliCloud_Resources/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// MongoDB 实例及其连接地址
+type MongoDBDetail struct {
+	database.MongoDBRecord
+	Endpoints []database.MongoDBEndpointRecord
+}
+
+// 处理 MongoDB 列表请求
+func handleMongoDBList(c *gin.Context) {
+	page, pageSize := getPaginationParams(c)
+
+	mongoRecords, err := database.ListMongoDBRecords()
+	if err != nil {
+		logger.Log.Error("查询 MongoDB 数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query MongoDB data"})
+		return
+	}
+	endpoints, err := database.ListMongoDBEndpoints()
+	if err != nil {
+		logger.Log.Error("查询 MongoDB 连接地址数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query MongoDB endpoint data"})
+		return
+	}
+
+	// 应用分页
+	total := len(mongoRecords)
+	paginatedData := applyPagination(mongoRecords, page, pageSize)
+
+	// 为当前页的实例附加连接地址
+	details := make([]MongoDBDetail, 0, len(paginatedData))
+	for _, rec := range paginatedData {
+		details = append(details, MongoDBDetail{MongoDBRecord: rec, Endpoints: endpoints[rec.InstanceID]})
+	}
+
+	c.JSON(200, PaginatedResponse{
+		Data:     details,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
@@ -0,0 +1,62 @@
+package api
+
+import (
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+	"github.com/WillemCode/AliCloud_Resources/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// 消息队列实例及其 Topic/Group
+type MQDetail struct {
+	database.MQInstanceRecord
+	Topics []database.MQResourceRecord
+	Groups []database.MQResourceRecord
+}
+
+// 处理消息队列列表请求：Kafka、RocketMQ、RabbitMQ 合并展示，可通过 type 参数筛选
+func handleMQList(c *gin.Context) {
+	page, pageSize := getPaginationParams(c)
+
+	instances, err := database.ListMQInstanceRecords()
+	if err != nil {
+		logger.Log.Error("查询消息队列数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query message queue data"})
+		return
+	}
+	if mqType := c.Query("type"); mqType != "" {
+		instances = filterRecords(instances, func(r database.MQInstanceRecord) bool { return r.MQType == mqType })
+	}
+	resources, err := database.ListMQResources("")
+	if err != nil {
+		logger.Log.Error("查询消息队列 Topic/Group 数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query message queue topic data"})
+		return
+	}
+
+	// 按实例分组 Topic/Group
+	topics := map[string][]database.MQResourceRecord{}
+	groups := map[string][]database.MQResourceRecord{}
+	for _, res := range resources {
+		if res.ResourceType == "topic" {
+			topics[res.InstanceID] = append(topics[res.InstanceID], res)
+		} else {
+			groups[res.InstanceID] = append(groups[res.InstanceID], res)
+		}
+	}
+
+	// 应用分页
+	total := len(instances)
+	paginatedData := applyPagination(instances, page, pageSize)
+
+	details := make([]MQDetail, 0, len(paginatedData))
+	for _, rec := range paginatedData {
+		details = append(details, MQDetail{MQInstanceRecord: rec, Topics: topics[rec.InstanceID], Groups: groups[rec.InstanceID]})
+	}
+
+	c.JSON(200, PaginatedResponse{
+		Data:     details,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
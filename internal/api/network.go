@@ -0,0 +1,408 @@
+package api
+
+import (
+	"net"
+	"strings"
+
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+	"github.com/WillemCode/AliCloud_Resources/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// 路由表及其路由条目
+type RouteTableDetail struct {
+	database.RouteTableRecord
+	Entries []database.RouteEntryRecord
+}
+
+// 部署在某个 VPC 或交换机内的全部资源
+type NetworkResources struct {
+	VSwitches      []database.VSwitchRecord       `json:",omitempty"`
+	RouteTables    []RouteTableDetail             `json:",omitempty"`
+	NatGateways    []database.NatGatewayRecord    `json:",omitempty"`
+	SecurityGroups []database.SecurityGroupRecord `json:",omitempty"`
+	ECS            []database.ECSRecord
+	RDS            []database.RDSRecord
+	SLB            []database.SLBRecord
+	LoadBalancers  []database.LoadBalancerRecord // ALB/NLB
+	Redis          []database.RedisRecord
+	PolarDB        []database.PolarDBRecord
+	MongoDB        []database.MongoDBRecord
+	MQ             []database.MQInstanceRecord // Kafka（RocketMQ/RabbitMQ 接口不返回网络信息）
+	ACK            []database.ACKClusterRecord
+	ESS            []database.ScalingGroupRecord
+	ECI            []database.ECIContainerGroupRecord
+	FC             []database.FCServiceRecord
+	Analytic       []database.AnalyticInstanceRecord
+	VPNGateways    []database.VPNGatewayRecord
+}
+
+// 一对网段重叠的 VPC
+type CidrOverlap struct {
+	VPCA        database.VPCRecord
+	CidrA       string
+	VPCB        database.VPCRecord
+	CidrB       string
+	SameAccount bool
+}
+
+// 处理 VPC 列表请求
+func handleVPCList(c *gin.Context) {
+	page, pageSize := getPaginationParams(c)
+
+	vpcs, err := database.ListVPCRecords()
+	if err != nil {
+		logger.Log.Error("查询 VPC 数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query VPC data"})
+		return
+	}
+
+	c.JSON(200, PaginatedResponse{
+		Data:     applyPagination(vpcs, page, pageSize),
+		Total:    len(vpcs),
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// 处理交换机列表请求
+func handleVSwitchList(c *gin.Context) {
+	page, pageSize := getPaginationParams(c)
+
+	vswitches, err := database.ListVSwitchRecords()
+	if err != nil {
+		logger.Log.Error("查询交换机数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query vSwitch data"})
+		return
+	}
+
+	c.JSON(200, PaginatedResponse{
+		Data:     applyPagination(vswitches, page, pageSize),
+		Total:    len(vswitches),
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// 处理 NAT 网关列表请求
+func handleNatGatewayList(c *gin.Context) {
+	page, pageSize := getPaginationParams(c)
+
+	natGateways, err := database.ListNatGatewayRecords()
+	if err != nil {
+		logger.Log.Error("查询 NAT 网关数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query NAT gateway data"})
+		return
+	}
+
+	c.JSON(200, PaginatedResponse{
+		Data:     applyPagination(natGateways, page, pageSize),
+		Total:    len(natGateways),
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// 处理 EIP 列表请求
+func handleEIPList(c *gin.Context) {
+	page, pageSize := getPaginationParams(c)
+
+	eips, err := database.ListEIPRecords()
+	if err != nil {
+		logger.Log.Error("查询 EIP 数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query EIP data"})
+		return
+	}
+
+	c.JSON(200, PaginatedResponse{
+		Data:     applyPagination(eips, page, pageSize),
+		Total:    len(eips),
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// 处理闲置 EIP 报表请求：列出未绑定任何实例、但仍在计费的 EIP
+func handleUnboundEIPs(c *gin.Context) {
+	page, pageSize := getPaginationParams(c)
+
+	eips, err := database.ListUnboundEIPRecords()
+	if err != nil {
+		logger.Log.Error("查询闲置 EIP 数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query unbound EIP data"})
+		return
+	}
+
+	c.JSON(200, PaginatedResponse{
+		Data:     applyPagination(eips, page, pageSize),
+		Total:    len(eips),
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// 处理单个 NAT 网关 SNAT/DNAT 条目请求
+func handleNatGatewayEntries(c *gin.Context) {
+	natGatewayID := c.Param("id")
+
+	snatEntries, err := database.ListSnatEntries(natGatewayID)
+	if err != nil {
+		logger.Log.Error("查询 SNAT 条目失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query SNAT entries"})
+		return
+	}
+	dnatEntries, err := database.ListDnatEntries(natGatewayID)
+	if err != nil {
+		logger.Log.Error("查询 DNAT 条目失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query DNAT entries"})
+		return
+	}
+
+	c.JSON(200, gin.H{"snat": snatEntries, "dnat": dnatEntries})
+}
+
+// 处理 VPC 内全部资源请求
+func handleVPCResources(c *gin.Context) {
+	vpcID := c.Param("id")
+
+	resources, err := collectNetworkResources(func(vpc, _ string) bool { return vpc == vpcID })
+	if err != nil {
+		logger.Log.Error("查询 VPC 资源失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query VPC resources"})
+		return
+	}
+
+	// VPC 级别额外返回交换机、路由表、NAT 网关及安全组
+	if err := collectVPCOnlyResources(resources, vpcID); err != nil {
+		logger.Log.Error("查询 VPC 资源失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query VPC resources"})
+		return
+	}
+
+	c.JSON(200, resources)
+}
+
+// collectVPCOnlyResources 补充 VPC 内的交换机、路由表（含路由条目）、NAT 网关及安全组
+func collectVPCOnlyResources(resources *NetworkResources, vpcID string) error {
+	vswitches, err := database.ListVSwitchRecords()
+	if err != nil {
+		return err
+	}
+	resources.VSwitches = filterRecords(vswitches, func(r database.VSwitchRecord) bool { return r.VPCID == vpcID })
+
+	routeTables, err := database.ListRouteTableRecords()
+	if err != nil {
+		return err
+	}
+	for _, rt := range filterRecords(routeTables, func(r database.RouteTableRecord) bool { return r.VPCID == vpcID }) {
+		entries, err := database.ListRouteEntries(rt.RouteTableID)
+		if err != nil {
+			return err
+		}
+		resources.RouteTables = append(resources.RouteTables, RouteTableDetail{RouteTableRecord: rt, Entries: entries})
+	}
+
+	natGateways, err := database.ListNatGatewayRecords()
+	if err != nil {
+		return err
+	}
+	resources.NatGateways = filterRecords(natGateways, func(r database.NatGatewayRecord) bool { return r.VPCID == vpcID })
+
+	securityGroups, err := database.ListSecurityGroupRecords()
+	if err != nil {
+		return err
+	}
+	resources.SecurityGroups = filterRecords(securityGroups, func(r database.SecurityGroupRecord) bool { return r.VPCID == vpcID })
+	return nil
+}
+
+// 处理交换机内全部资源请求
+func handleVSwitchResources(c *gin.Context) {
+	vswitchID := c.Param("id")
+
+	resources, err := collectNetworkResources(func(_, vswitch string) bool { return containsID(vswitch, vswitchID) })
+	if err != nil {
+		logger.Log.Error("查询交换机资源失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query vSwitch resources"})
+		return
+	}
+	c.JSON(200, resources)
+}
+
+// 处理跨账户网段重叠检测请求
+// scope=all 时同时检测同一账户内的 VPC，默认仅检测不同账户之间的 VPC
+func handleCidrOverlaps(c *gin.Context) {
+	crossAccountOnly := c.DefaultQuery("scope", "cross-account") != "all"
+
+	vpcs, err := database.ListVPCRecords()
+	if err != nil {
+		logger.Log.Error("查询 VPC 数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query VPC data"})
+		return
+	}
+
+	overlaps := []CidrOverlap{}
+	for i := 0; i < len(vpcs); i++ {
+		for j := i + 1; j < len(vpcs); j++ {
+			a, b := vpcs[i], vpcs[j]
+			sameAccount := a.CloudName == b.CloudName
+			if crossAccountOnly && sameAccount {
+				continue
+			}
+			for _, cidrA := range vpcCidrBlocks(a) {
+				for _, cidrB := range vpcCidrBlocks(b) {
+					if cidrsOverlap(cidrA, cidrB) {
+						overlaps = append(overlaps, CidrOverlap{
+							VPCA:        a,
+							CidrA:       cidrA,
+							VPCB:        b,
+							CidrB:       cidrB,
+							SameAccount: sameAccount,
+						})
+					}
+				}
+			}
+		}
+	}
+
+	c.JSON(200, gin.H{"data": overlaps, "total": len(overlaps)})
+}
+
+// 按所属 VPC/交换机筛选 ECS、RDS、SLB（含 ALB/NLB）、Redis、PolarDB、MongoDB、消息队列、ACK 集群、伸缩组、ECI、FC 服务、分析型数据库及 VPN 网关资源
+func collectNetworkResources(match func(vpcID, vswitchID string) bool) (*NetworkResources, error) {
+	resources := &NetworkResources{}
+
+	ecsRecords, err := database.ListECSRecords()
+	if err != nil {
+		return nil, err
+	}
+	resources.ECS = filterRecords(ecsRecords, func(r database.ECSRecord) bool { return match(r.VPCID, r.VSwitchID) })
+
+	rdsRecords, err := database.ListRDSRecords()
+	if err != nil {
+		return nil, err
+	}
+	resources.RDS = filterRecords(rdsRecords, func(r database.RDSRecord) bool { return match(r.VPCID, r.VSwitchID) })
+
+	slbRecords, err := database.ListSLBRecords()
+	if err != nil {
+		return nil, err
+	}
+	resources.SLB = filterRecords(slbRecords, func(r database.SLBRecord) bool { return match(r.VPCID, r.VSwitchID) })
+
+	lbRecords, err := database.ListLoadBalancerRecords()
+	if err != nil {
+		return nil, err
+	}
+	resources.LoadBalancers = filterRecords(lbRecords, func(r database.LoadBalancerRecord) bool { return match(r.VPCID, r.VSwitchID) })
+
+	redisRecords, err := database.ListRedisRecords()
+	if err != nil {
+		return nil, err
+	}
+	resources.Redis = filterRecords(redisRecords, func(r database.RedisRecord) bool { return match(r.VPCID, r.VSwitchID) })
+
+	polarDBRecords, err := database.ListPolarDBRecords()
+	if err != nil {
+		return nil, err
+	}
+	resources.PolarDB = filterRecords(polarDBRecords, func(r database.PolarDBRecord) bool { return match(r.VPCID, r.VSwitchID) })
+
+	mongoRecords, err := database.ListMongoDBRecords()
+	if err != nil {
+		return nil, err
+	}
+	resources.MongoDB = filterRecords(mongoRecords, func(r database.MongoDBRecord) bool { return match(r.VPCID, r.VSwitchID) })
+
+	mqRecords, err := database.ListMQInstanceRecords()
+	if err != nil {
+		return nil, err
+	}
+	resources.MQ = filterRecords(mqRecords, func(r database.MQInstanceRecord) bool { return match(r.VPCID, r.VSwitchID) })
+
+	ackRecords, err := database.ListACKClusterRecords()
+	if err != nil {
+		return nil, err
+	}
+	resources.ACK = filterRecords(ackRecords, func(r database.ACKClusterRecord) bool { return match(r.VPCID, r.VSwitchID) })
+
+	essRecords, err := database.ListScalingGroupRecords()
+	if err != nil {
+		return nil, err
+	}
+	resources.ESS = filterRecords(essRecords, func(r database.ScalingGroupRecord) bool { return match(r.VPCID, r.VSwitchIDs) })
+
+	eciRecords, err := database.ListECIContainerGroupRecords()
+	if err != nil {
+		return nil, err
+	}
+	resources.ECI = filterRecords(eciRecords, func(r database.ECIContainerGroupRecord) bool { return match(r.VPCID, r.VSwitchID) })
+
+	fcRecords, err := database.ListFCServiceRecords()
+	if err != nil {
+		return nil, err
+	}
+	resources.FC = filterRecords(fcRecords, func(r database.FCServiceRecord) bool { return match(r.VPCID, r.VSwitchIDs) })
+
+	analyticRecords, err := database.ListAnalyticInstanceRecords()
+	if err != nil {
+		return nil, err
+	}
+	resources.Analytic = filterRecords(analyticRecords, func(r database.AnalyticInstanceRecord) bool { return match(r.VPCID, r.VSwitchID) })
+
+	vpnRecords, err := database.ListVPNGatewayRecords()
+	if err != nil {
+		return nil, err
+	}
+	resources.VPNGateways = filterRecords(vpnRecords, func(r database.VPNGatewayRecord) bool { return match(r.VPCID, r.VSwitchID) })
+
+	return resources, nil
+}
+
+// 返回切片中满足条件的记录
+func filterRecords[T any](records []T, keep func(T) bool) []T {
+	results := []T{}
+	for _, rec := range records {
+		if keep(rec) {
+			results = append(results, rec)
+		}
+	}
+	return results
+}
+
+// 判断逗号分隔的 ID 列表中是否包含指定 ID（PolarDB 等资源可能跨多个交换机）
+func containsID(list, id string) bool {
+	if id == "" {
+		return false
+	}
+	for _, item := range strings.Split(list, ",") {
+		if strings.TrimSpace(item) == id {
+			return true
+		}
+	}
+	return false
+}
+
+// VPC 的主网段与附加网段
+func vpcCidrBlocks(v database.VPCRecord) []string {
+	var cidrs []string
+	if v.CidrBlock != "" {
+		cidrs = append(cidrs, v.CidrBlock)
+	}
+	for _, cidr := range strings.Split(v.SecondaryCidrBlocks, ",") {
+		if cidr = strings.TrimSpace(cidr); cidr != "" {
+			cidrs = append(cidrs, cidr)
+		}
+	}
+	return cidrs
+}
+
+// 判断两个网段是否重叠：任一网段包含另一网段的网络地址即视为重叠
+func cidrsOverlap(a, b string) bool {
+	_, netA, errA := net.ParseCIDR(a)
+	_, netB, errB := net.ParseCIDR(b)
+	if errA != nil || errB != nil {
+		return false
+	}
+	return netA.Contains(netB.IP) || netB.Contains(netA.IP)
+}
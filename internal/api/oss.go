@@ -0,0 +1,76 @@
+package api
+
+import (
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+	"github.com/WillemCode/AliCloud_Resources/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// 可公开访问的 Bucket 及判定原因
+type OSSPublicBucket struct {
+	database.OSSBucketRecord
+	Reasons []string
+}
+
+// 处理 OSS Bucket 列表请求
+func handleOSSList(c *gin.Context) {
+	page, pageSize := getPaginationParams(c)
+
+	buckets, err := database.ListOSSBucketRecords()
+	if err != nil {
+		logger.Log.Error("查询 OSS 数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query OSS data"})
+		return
+	}
+
+	c.JSON(200, PaginatedResponse{
+		Data:     applyPagination(buckets, page, pageSize),
+		Total:    len(buckets),
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// 处理公开 Bucket 报表请求：汇总所有账户下 ACL 或 Bucket Policy 允许匿名访问、且未开启阻止公共访问的 Bucket
+func handlePublicOSSBuckets(c *gin.Context) {
+	page, pageSize := getPaginationParams(c)
+
+	buckets, err := database.ListOSSBucketRecords()
+	if err != nil {
+		logger.Log.Error("查询 OSS 数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query OSS data"})
+		return
+	}
+
+	results := make([]OSSPublicBucket, 0)
+	for _, rec := range buckets {
+		if reasons := ossPublicReasons(rec); len(reasons) > 0 {
+			results = append(results, OSSPublicBucket{OSSBucketRecord: rec, Reasons: reasons})
+		}
+	}
+
+	c.JSON(200, PaginatedResponse{
+		Data:     applyPagination(results, page, pageSize),
+		Total:    len(results),
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// 返回 Bucket 可被公开访问的原因，开启阻止公共访问后 ACL 和 Policy 均不生效
+func ossPublicReasons(rec database.OSSBucketRecord) []string {
+	if rec.BlockPublicAccess {
+		return nil
+	}
+	var reasons []string
+	switch rec.ACL {
+	case "public-read":
+		reasons = append(reasons, "ACL 公共读")
+	case "public-read-write":
+		reasons = append(reasons, "ACL 公共读写")
+	}
+	if rec.PolicyPublic {
+		reasons = append(reasons, "Bucket Policy 允许公共访问")
+	}
+	return reasons
+}
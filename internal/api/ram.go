@@ -0,0 +1,229 @@
+package api
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+	"github.com/WillemCode/AliCloud_Resources/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// AccessKey 闲置报表默认天数
+const defaultStaleKeyDays = 90
+
+// RAM 用户及其 AccessKey、权限策略
+type RAMUserDetail struct {
+	database.RAMUserRecord
+	AccessKeys []database.RAMAccessKeyRecord
+	Policies   []database.RAMPolicyAttachmentRecord
+}
+
+// RAM 用户组及其权限策略
+type RAMGroupDetail struct {
+	database.RAMGroupRecord
+	Policies []database.RAMPolicyAttachmentRecord
+}
+
+// RAM 角色及其权限策略
+type RAMRoleDetail struct {
+	database.RAMRoleRecord
+	Policies []database.RAMPolicyAttachmentRecord
+}
+
+// 闲置的 AccessKey 及判定原因
+type StaleAccessKey struct {
+	database.RAMAccessKeyRecord
+	IdleDays int    // 距最后使用（从未使用则为创建）的天数，最后使用时间未知时为 0
+	Reason   string // 判定原因
+}
+
+// 处理 RAM 用户列表请求，附带 AccessKey 和直接授予的权限策略
+func handleRAMUserList(c *gin.Context) {
+	page, pageSize := getPaginationParams(c)
+
+	users, err := database.ListRAMUserRecords()
+	if err != nil {
+		logger.Log.Error("查询 RAM 用户数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query RAM user data"})
+		return
+	}
+	keys, err := database.ListRAMAccessKeyRecords()
+	if err != nil {
+		logger.Log.Error("查询 AccessKey 数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query RAM user data"})
+		return
+	}
+	policies, err := database.ListRAMPolicyAttachments()
+	if err != nil {
+		logger.Log.Error("查询权限策略授权数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query RAM user data"})
+		return
+	}
+
+	keysByUser := map[string][]database.RAMAccessKeyRecord{}
+	for _, key := range keys {
+		userKey := database.RAMPrincipalKey(key.CloudName, database.RAMPrincipalUser, key.UserName)
+		keysByUser[userKey] = append(keysByUser[userKey], key)
+	}
+
+	results := make([]RAMUserDetail, 0, pageSize)
+	for _, user := range applyPagination(users, page, pageSize) {
+		userKey := database.RAMPrincipalKey(user.CloudName, database.RAMPrincipalUser, user.UserName)
+		results = append(results, RAMUserDetail{
+			RAMUserRecord: user,
+			AccessKeys:    keysByUser[userKey],
+			Policies:      policies[userKey],
+		})
+	}
+
+	c.JSON(200, PaginatedResponse{
+		Data:     results,
+		Total:    len(users),
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// 处理 RAM 用户组列表请求
+func handleRAMGroupList(c *gin.Context) {
+	page, pageSize := getPaginationParams(c)
+
+	groups, err := database.ListRAMGroupRecords()
+	if err != nil {
+		logger.Log.Error("查询 RAM 用户组数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query RAM group data"})
+		return
+	}
+	policies, err := database.ListRAMPolicyAttachments()
+	if err != nil {
+		logger.Log.Error("查询权限策略授权数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query RAM group data"})
+		return
+	}
+
+	results := make([]RAMGroupDetail, 0, pageSize)
+	for _, group := range applyPagination(groups, page, pageSize) {
+		results = append(results, RAMGroupDetail{
+			RAMGroupRecord: group,
+			Policies:       policies[database.RAMPrincipalKey(group.CloudName, database.RAMPrincipalGroup, group.GroupName)],
+		})
+	}
+
+	c.JSON(200, PaginatedResponse{
+		Data:     results,
+		Total:    len(groups),
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// 处理 RAM 角色列表请求
+func handleRAMRoleList(c *gin.Context) {
+	page, pageSize := getPaginationParams(c)
+
+	roles, err := database.ListRAMRoleRecords()
+	if err != nil {
+		logger.Log.Error("查询 RAM 角色数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query RAM role data"})
+		return
+	}
+	policies, err := database.ListRAMPolicyAttachments()
+	if err != nil {
+		logger.Log.Error("查询权限策略授权数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query RAM role data"})
+		return
+	}
+
+	results := make([]RAMRoleDetail, 0, pageSize)
+	for _, role := range applyPagination(roles, page, pageSize) {
+		results = append(results, RAMRoleDetail{
+			RAMRoleRecord: role,
+			Policies:      policies[database.RAMPrincipalKey(role.CloudName, database.RAMPrincipalRole, role.RoleName)],
+		})
+	}
+
+	c.JSON(200, PaginatedResponse{
+		Data:     results,
+		Total:    len(roles),
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// 处理 AccessKey 列表请求
+func handleRAMAccessKeyList(c *gin.Context) {
+	page, pageSize := getPaginationParams(c)
+
+	keys, err := database.ListRAMAccessKeyRecords()
+	if err != nil {
+		logger.Log.Error("查询 AccessKey 数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query access key data"})
+		return
+	}
+
+	c.JSON(200, PaginatedResponse{
+		Data:     applyPagination(keys, page, pageSize),
+		Total:    len(keys),
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// 处理闲置 AccessKey 报表请求：汇总所有账户下 days 天（默认 90 天）内未使用、或创建后从未使用的 AccessKey
+func handleStaleAccessKeys(c *gin.Context) {
+	page, pageSize := getPaginationParams(c)
+
+	days := defaultStaleKeyDays
+	if daysStr := c.Query("days"); daysStr != "" {
+		d, err := strconv.Atoi(daysStr)
+		if err != nil || d < 0 {
+			c.JSON(400, gin.H{"error": "invalid days: " + daysStr})
+			return
+		}
+		days = d
+	}
+
+	keys, err := database.ListRAMAccessKeyRecords()
+	if err != nil {
+		logger.Log.Error("查询 AccessKey 数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query access key data"})
+		return
+	}
+
+	now := time.Now()
+	results := make([]StaleAccessKey, 0)
+	for _, key := range keys {
+		if item, ok := staleAccessKey(key, days, now); ok {
+			results = append(results, item)
+		}
+	}
+
+	c.JSON(200, PaginatedResponse{
+		Data:     applyPagination(results, page, pageSize),
+		Total:    len(results),
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// 判断 AccessKey 是否闲置：从未使用时按创建时间计算，否则按最后使用时间计算
+// 最后使用时间查询失败的 AccessKey 无法判断，创建已超过 days 天时单独标记为使用情况未知
+func staleAccessKey(key database.RAMAccessKeyRecord, days int, now time.Time) (StaleAccessKey, bool) {
+	since, reason := key.LastUsedDate, "超过 "+strconv.Itoa(days)+" 天未使用"
+	if since == "" {
+		since, reason = key.CreateDate, "创建后从未使用"
+	}
+	t, err := time.Parse(time.RFC3339, since)
+	if err != nil {
+		return StaleAccessKey{}, false
+	}
+	idleDays := int(now.Sub(t).Hours() / 24)
+	if idleDays < days {
+		return StaleAccessKey{}, false
+	}
+	if key.LastUsedUnknown {
+		return StaleAccessKey{RAMAccessKeyRecord: key, Reason: "最后使用时间查询失败，使用情况未知"}, true
+	}
+	return StaleAccessKey{RAMAccessKeyRecord: key, IdleDays: idleDays, Reason: reason}, true
+}
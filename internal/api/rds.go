@@ -0,0 +1,55 @@
+package api
+
+import (
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+	"github.com/WillemCode/AliCloud_Resources/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// 单个 RDS 实例的数据库、账号、授权及白名单
+type RDSDetail struct {
+	Databases  []database.RDSDatabaseRecord
+	Accounts   []database.RDSAccountRecord
+	Privileges []database.RDSAccountPrivilegeRecord
+	Whitelists []database.RDSWhitelistRecord
+}
+
+// 处理全部 RDS 数据库列表请求，可通过 instance_id 参数筛选实例
+func handleRDSDatabaseList(c *gin.Context) {
+	page, pageSize := getPaginationParams(c)
+
+	databases, err := database.ListRDSDatabases(c.Query("instance_id"))
+	if err != nil {
+		logger.Log.Error("查询 RDS 数据库数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query RDS database data"})
+		return
+	}
+
+	c.JSON(200, PaginatedResponse{
+		Data:     applyPagination(databases, page, pageSize),
+		Total:    len(databases),
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// 处理单个 RDS 实例详情请求：数据库、账号及授权、IP 白名单分组
+func handleRDSDetail(c *gin.Context) {
+	instanceID := c.Param("id")
+	detail := RDSDetail{}
+
+	var err error
+	if detail.Databases, err = database.ListRDSDatabases(instanceID); err == nil {
+		if detail.Accounts, err = database.ListRDSAccounts(instanceID); err == nil {
+			if detail.Privileges, err = database.ListRDSAccountPrivileges(instanceID); err == nil {
+				detail.Whitelists, err = database.ListRDSWhitelists(instanceID)
+			}
+		}
+	}
+	if err != nil {
+		logger.Log.Error("查询 RDS 实例详情失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query RDS detail"})
+		return
+	}
+	c.JSON(200, detail)
+}
@@ -0,0 +1,216 @@
+package api
+
+import (
+	"strconv"
+
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+	"github.com/WillemCode/AliCloud_Resources/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// 闲置资源判定原因
+const (
+	ReasonLowCPU        = "low_cpu"        // CPU 平均值及峰值均低于阈值
+	ReasonLowMemory     = "low_memory"     // 内存平均使用率低于阈值
+	ReasonLowConnection = "low_connection" // 连接数平均使用率低于阈值
+	ReasonStoppedBilled = "stopped_billed" // 已停止但最近账期仍在计费（按整个账期判断，月中停止的实例也会计入）
+	ReasonStopped       = "stopped"        // 已停止，最近账期未找到账单（可能为停机不收费，或账单未同步）
+)
+
+// 闲置资源检测阈值（%）
+type rightsizingThresholds struct {
+	CPU        float64
+	CPUMax     float64
+	Memory     float64
+	Connection float64
+}
+
+// 单个实例的闲置检测结果
+type RightsizingFinding struct {
+	ResourceType string
+	InstanceID   string
+	InstanceName string
+	CloudName    string
+	RegionID     string
+	Status       string
+	Spec         string                                   // 实例规格
+	Metrics      map[string]database.ResourceMetricRecord `json:",omitempty"`
+	MonthlyCost  float64                                  // 最近账期的应付金额，未同步账单时为 0
+	Reasons      []string
+}
+
+// 处理闲置资源报表请求：按云监控指标汇总找出持续低负载实例，并结合账单找出已停止仍计费的实例
+// 支持 type=<资源类型>、account=<账户>、reason=<原因> 筛选，阈值可通过 cpu、cpu_max、memory、connection 参数调整
+func handleRightsizing(c *gin.Context) {
+	page, pageSize := getPaginationParams(c)
+	thresholds := rightsizingThresholds{
+		CPU:        queryFloat(c, "cpu", 10),
+		CPUMax:     queryFloat(c, "cpu_max", 30),
+		Memory:     queryFloat(c, "memory", 20),
+		Connection: queryFloat(c, "connection", 10),
+	}
+
+	metrics, err := database.ListResourceMetrics()
+	if err != nil {
+		logger.Log.Error("查询资源指标数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query metric data"})
+		return
+	}
+	costs, err := latestCostByInstance()
+	if err != nil {
+		logger.Log.Error("查询账单费用数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query cost data"})
+		return
+	}
+	candidates, err := loadRightsizingCandidates()
+	if err != nil {
+		logger.Log.Error("查询实例数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query instance data"})
+		return
+	}
+
+	findings := []RightsizingFinding{}
+	for _, f := range candidates {
+		f.Metrics = metrics[f.ResourceType+"/"+f.InstanceID]
+		f.MonthlyCost = costs[f.InstanceID]
+		f.Reasons = rightsizingReasons(f, thresholds)
+		if len(f.Reasons) > 0 {
+			findings = append(findings, f)
+		}
+	}
+
+	if resourceType := c.Query("type"); resourceType != "" {
+		findings = filterRecords(findings, func(r RightsizingFinding) bool { return r.ResourceType == resourceType })
+	}
+	if account := c.Query("account"); account != "" {
+		findings = filterRecords(findings, func(r RightsizingFinding) bool { return r.CloudName == account })
+	}
+	if reason := c.Query("reason"); reason != "" {
+		findings = filterRecords(findings, func(r RightsizingFinding) bool { return containsString(r.Reasons, reason) })
+	}
+
+	c.JSON(200, PaginatedResponse{
+		Data:     applyPagination(findings, page, pageSize),
+		Total:    len(findings),
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// 各资源类型表示已停止的实例状态
+var stoppedStatuses = map[string][]string{
+	database.ResourceTypeECS:     {"Stopped"},
+	database.ResourceTypeRDS:     {"Stopping", "Stopped"}, // 暂停中、已暂停
+	database.ResourceTypeRedis:   {"Inactive"},            // 已禁用
+	database.ResourceTypePolarDB: {"Stopped"},
+}
+
+// rightsizingReasons 判定实例的闲置原因：已停止的实例只检查计费，运行中的实例按指标阈值判断。
+// 账单只同步到账期粒度，已停止的实例只要在最近账期内有费用即判定为 stopped_billed，不区分停止前后产生的费用
+func rightsizingReasons(f RightsizingFinding, t rightsizingThresholds) []string {
+	if containsString(stoppedStatuses[f.ResourceType], f.Status) {
+		if f.MonthlyCost > 0 {
+			return []string{ReasonStoppedBilled}
+		}
+		return []string{ReasonStopped}
+	}
+
+	var reasons []string
+	if cpu, ok := f.Metrics[database.MetricCPU]; ok && cpu.Average < t.CPU && cpu.Maximum < t.CPUMax {
+		reasons = append(reasons, ReasonLowCPU)
+	}
+	if memory, ok := f.Metrics[database.MetricMemory]; ok && memory.Average < t.Memory {
+		reasons = append(reasons, ReasonLowMemory)
+	}
+	if connection, ok := f.Metrics[database.MetricConnection]; ok && connection.Average < t.Connection {
+		reasons = append(reasons, ReasonLowConnection)
+	}
+	return reasons
+}
+
+// loadRightsizingCandidates 汇总 ECS、RDS、Redis、PolarDB 实例作为检测对象
+func loadRightsizingCandidates() ([]RightsizingFinding, error) {
+	var candidates []RightsizingFinding
+
+	ecsRecords, err := database.ListECSRecords()
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range ecsRecords {
+		candidates = append(candidates, RightsizingFinding{
+			ResourceType: database.ResourceTypeECS, InstanceID: r.InstanceID, InstanceName: r.InstanceName, CloudName: r.CloudName,
+			RegionID: r.RegionID, Status: r.Status, Spec: r.InstanceType,
+		})
+	}
+
+	rdsRecords, err := database.ListRDSRecords()
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range rdsRecords {
+		candidates = append(candidates, RightsizingFinding{
+			ResourceType: database.ResourceTypeRDS, InstanceID: r.InstanceID, InstanceName: r.Description, CloudName: r.CloudName,
+			RegionID: r.RegionID, Status: r.Status, Spec: r.InstanceClass,
+		})
+	}
+
+	redisRecords, err := database.ListRedisRecords()
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range redisRecords {
+		candidates = append(candidates, RightsizingFinding{
+			ResourceType: database.ResourceTypeRedis, InstanceID: r.InstanceID, InstanceName: r.InstanceName, CloudName: r.CloudName,
+			RegionID: r.RegionId, Status: r.Status, Spec: r.InstanceClass,
+		})
+	}
+
+	polarRecords, err := database.ListPolarDBRecords()
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range polarRecords {
+		candidates = append(candidates, RightsizingFinding{
+			ResourceType: database.ResourceTypePolarDB, InstanceID: r.InstanceID, InstanceName: r.Description, CloudName: r.CloudName,
+			RegionID: r.RegionID, Status: r.Status,
+		})
+	}
+
+	return candidates, nil
+}
+
+// latestCostByInstance 汇总最近一个已同步账期内每个实例的应付金额
+func latestCostByInstance() (map[string]float64, error) {
+	costs := map[string]float64{}
+	cycles, err := database.ListBillingCycles()
+	if err != nil || len(cycles) == 0 {
+		return costs, err
+	}
+	records, err := database.ListCostRecords(cycles[0])
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range records {
+		costs[rec.InstanceID] += rec.PretaxAmount
+	}
+	return costs, nil
+}
+
+// queryFloat 读取浮点型查询参数，缺省或无法解析时返回默认值
+func queryFloat(c *gin.Context, key string, def float64) float64 {
+	value, err := strconv.ParseFloat(c.Query(key), 64)
+	if err != nil {
+		return def
+	}
+	return value
+}
+
+// containsString 判断切片中是否包含指定字符串
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,170 @@
+package api
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+	"github.com/WillemCode/AliCloud_Resources/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// 默认视为敏感的端口：SSH、RDP、MySQL
+var defaultSensitivePorts = []int{22, 3389, 3306}
+
+// 暴露面报表中的一行：实例在某个敏感端口上对公网放通，附带最终生效的放通规则
+type ExposureItem struct {
+	database.ExposureRecord
+	Port int
+}
+
+// 处理安全组列表请求
+func handleSecurityGroupList(c *gin.Context) {
+	page, pageSize := getPaginationParams(c)
+
+	groups, err := database.ListSecurityGroupRecords()
+	if err != nil {
+		logger.Log.Error("查询安全组数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query security group data"})
+		return
+	}
+
+	// 应用分页
+	total := len(groups)
+	paginatedData := applyPagination(groups, page, pageSize)
+
+	c.JSON(200, PaginatedResponse{
+		Data:     paginatedData,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// 处理单个安全组规则列表请求
+func handleSecurityGroupRules(c *gin.Context) {
+	rules, err := database.ListSecurityGroupRules(c.Param("id"))
+	if err != nil {
+		logger.Log.Error("查询安全组规则失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query security group rules"})
+		return
+	}
+	c.JSON(200, gin.H{"data": rules})
+}
+
+// 处理暴露面报表请求：列出带公网 IP、且在敏感端口上对公网放通的 ECS 实例
+// 可通过 ports 参数覆盖默认端口，例如 /exposure?ports=22,3389,6379
+func handleExposure(c *gin.Context) {
+	ports := defaultSensitivePorts
+	if portsStr := c.Query("ports"); portsStr != "" {
+		ports = nil
+		for _, p := range strings.Split(portsStr, ",") {
+			port, err := strconv.Atoi(strings.TrimSpace(p))
+			if err != nil || port < 1 || port > 65535 {
+				c.JSON(400, gin.H{"error": "invalid port: " + p})
+				return
+			}
+			ports = append(ports, port)
+		}
+	}
+
+	records, err := database.ListExposureRecords()
+	if err != nil {
+		logger.Log.Error("查询暴露面数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query exposure data"})
+		return
+	}
+
+	// 查询结果已按实例排序，同一实例的规则相邻，逐实例、逐端口判定最终生效的规则
+	results := make([]ExposureItem, 0)
+	for start := 0; start < len(records); {
+		end := start
+		for end < len(records) && records[end].InstanceID == records[start].InstanceID {
+			end++
+		}
+		for _, port := range ports {
+			if rule, ok := effectiveAcceptRule(records[start:end], port); ok {
+				results = append(results, ExposureItem{ExposureRecord: rule, Port: port})
+			}
+		}
+		start = end
+	}
+
+	page, pageSize := getPaginationParams(c)
+	total := len(results)
+	paginatedData := applyPagination(results, page, pageSize)
+
+	c.JSON(200, PaginatedResponse{
+		Data:     paginatedData,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// effectiveAcceptRule 判定实例的全部公网入方向规则在指定端口上是否放通，放通时返回生效的 accept 规则
+// 按源地址段（IPv4/IPv6）和协议（TCP/UDP）分别取优先级最高的规则（数值越小越优先），同优先级时 drop 优先
+func effectiveAcceptRule(rules []database.ExposureRecord, port int) (database.ExposureRecord, bool) {
+	for _, cidr := range []string{"0.0.0.0/0", "::/0"} {
+		for _, protocol := range []string{"tcp", "udp"} {
+			winner := -1
+			winnerPriority := 0
+			for i, rule := range rules {
+				if rule.SourceCidrIP != cidr || !ruleCoversPort(rule.IPProtocol, rule.PortRange, protocol, port) {
+					continue
+				}
+				priority := rulePriority(rule.Priority)
+				if winner == -1 || priority < winnerPriority || (priority == winnerPriority && isDropPolicy(rule.Policy)) {
+					winner, winnerPriority = i, priority
+				}
+			}
+			if winner != -1 && !isDropPolicy(rules[winner].Policy) {
+				return rules[winner], true
+			}
+		}
+	}
+	return database.ExposureRecord{}, false
+}
+
+// 判断规则是否作用于指定协议的端口，规则协议为 ALL 时匹配任意协议
+// 阿里云端口范围格式为 "起始/结束"，"-1/-1" 表示不限端口
+func ruleCoversPort(ruleProtocol, portRange, protocol string, port int) bool {
+	ruleProtocol = strings.ToLower(ruleProtocol)
+	if ruleProtocol != protocol && ruleProtocol != "all" {
+		// ICMP、GRE 等协议不涉及端口
+		return false
+	}
+	from, to, ok := parsePortRange(portRange)
+	return ok && port >= from && port <= to
+}
+
+// 解析规则优先级，缺省或无法解析时按阿里云默认值 1 处理
+func rulePriority(priority string) int {
+	value, err := strconv.Atoi(priority)
+	if err != nil {
+		return 1
+	}
+	return value
+}
+
+// 判断授权策略是否为拒绝
+func isDropPolicy(policy string) bool {
+	return strings.EqualFold(policy, "drop")
+}
+
+// 解析端口范围字符串
+func parsePortRange(portRange string) (int, int, bool) {
+	parts := strings.SplitN(portRange, "/", 2)
+	if len(parts) != 2 {
+		return 0, 0, false
+	}
+	from, err1 := strconv.Atoi(parts[0])
+	to, err2 := strconv.Atoi(parts[1])
+	if err1 != nil || err2 != nil {
+		return 0, 0, false
+	}
+	if from == -1 && to == -1 {
+		return 1, 65535, true
+	}
+	return from, to, true
+}
@@ -0,0 +1,74 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+)
+
+func TestParsePortRange(t *testing.T) {
+	tests := []struct {
+		portRange string
+		from, to  int
+		ok        bool
+	}{
+		{"-1/-1", 1, 65535, true},
+		{"22/22", 22, 22, true},
+		{"1000/2000", 1000, 2000, true},
+		{"22", 0, 0, false},
+		{"", 0, 0, false},
+		{"a/22", 0, 0, false},
+		{"22/b", 0, 0, false},
+	}
+	for _, tt := range tests {
+		from, to, ok := parsePortRange(tt.portRange)
+		if from != tt.from || to != tt.to || ok != tt.ok {
+			t.Errorf("parsePortRange(%q) = %d, %d, %v; want %d, %d, %v", tt.portRange, from, to, ok, tt.from, tt.to, tt.ok)
+		}
+	}
+}
+
+func TestEffectiveAcceptRule(t *testing.T) {
+	rule := func(protocol, portRange, cidr, policy, priority string) database.ExposureRecord {
+		return database.ExposureRecord{InstanceID: "i-1", IPProtocol: protocol, PortRange: portRange, SourceCidrIP: cidr, Policy: policy, Priority: priority}
+	}
+	tests := []struct {
+		name  string
+		rules []database.ExposureRecord
+		port  int
+		want  bool
+	}{
+		{"accept only", []database.ExposureRecord{rule("TCP", "22/22", "0.0.0.0/0", "accept", "1")}, 22, true},
+		{"port not covered", []database.ExposureRecord{rule("TCP", "80/80", "0.0.0.0/0", "accept", "1")}, 22, false},
+		{"icmp ignored", []database.ExposureRecord{rule("ICMP", "-1/-1", "0.0.0.0/0", "accept", "1")}, 22, false},
+		{"higher priority drop", []database.ExposureRecord{
+			rule("TCP", "22/22", "0.0.0.0/0", "accept", "10"),
+			rule("TCP", "22/22", "0.0.0.0/0", "drop", "1"),
+		}, 22, false},
+		{"drop wins on tie", []database.ExposureRecord{
+			rule("TCP", "-1/-1", "0.0.0.0/0", "accept", "1"),
+			rule("TCP", "1/1024", "0.0.0.0/0", "drop", "1"),
+		}, 22, false},
+		{"lower priority drop", []database.ExposureRecord{
+			rule("TCP", "22/22", "0.0.0.0/0", "accept", "1"),
+			rule("TCP", "22/22", "0.0.0.0/0", "drop", "10"),
+		}, 22, true},
+		{"drop on other protocol", []database.ExposureRecord{
+			rule("TCP", "22/22", "0.0.0.0/0", "accept", "1"),
+			rule("UDP", "22/22", "0.0.0.0/0", "drop", "1"),
+		}, 22, true},
+		{"ipv4 drop keeps ipv6 accept", []database.ExposureRecord{
+			rule("TCP", "22/22", "::/0", "accept", "1"),
+			rule("TCP", "22/22", "0.0.0.0/0", "drop", "1"),
+		}, 22, true},
+	}
+	for _, tt := range tests {
+		got, ok := effectiveAcceptRule(tt.rules, tt.port)
+		if ok != tt.want {
+			t.Errorf("%s: effectiveAcceptRule() ok = %v; want %v", tt.name, ok, tt.want)
+		}
+		if ok && isDropPolicy(got.Policy) {
+			t.Errorf("%s: effectiveAcceptRule() returned drop rule", tt.name)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package api
+
+import (
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+	"github.com/WillemCode/AliCloud_Resources/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// SLS Project 及其 Logstore
+type SLSProjectDetail struct {
+	database.SLSProjectRecord
+	ShardCount int64 // 所有 Logstore 的 Shard 总数
+	Logstores  []database.SLSLogstoreRecord
+}
+
+// 处理 SLS Project 列表请求
+func handleSLSProjectList(c *gin.Context) {
+	page, pageSize := getPaginationParams(c)
+
+	projects, err := database.ListSLSProjectRecords()
+	if err != nil {
+		logger.Log.Error("查询 SLS 数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query SLS data"})
+		return
+	}
+	logstores, err := database.ListSLSLogstores()
+	if err != nil {
+		logger.Log.Error("查询 Logstore 数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query logstore data"})
+		return
+	}
+
+	// 应用分页
+	total := len(projects)
+	paginatedData := applyPagination(projects, page, pageSize)
+
+	details := make([]SLSProjectDetail, 0, len(paginatedData))
+	for _, rec := range paginatedData {
+		detail := SLSProjectDetail{SLSProjectRecord: rec, Logstores: logstores[rec.ProjectName]}
+		for _, logstore := range detail.Logstores {
+			detail.ShardCount += logstore.ShardCount
+		}
+		details = append(details, detail)
+	}
+
+	c.JSON(200, PaginatedResponse{
+		Data:     details,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
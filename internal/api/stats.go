@@ -0,0 +1,111 @@
+package api
+
+import (
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+	"github.com/WillemCode/AliCloud_Resources/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// 处理统计概览请求：资源数、状态分布、ECS 容量及规格/系统分布、数据库引擎版本分布
+// 支持 account=<账户>、region=<区域>、type=<资源类型> 筛选，ECS 相关统计只使用账户和区域条件
+func handleStats(c *gin.Context) {
+	filter := database.StatsFilter{
+		CloudName:    c.Query("account"),
+		RegionID:     c.Query("region"),
+		ResourceType: c.Query("type"),
+	}
+
+	resources, err := database.CountResources(filter)
+	if err != nil {
+		logger.Log.Error("统计资源数失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query resource stats"})
+		return
+	}
+	status, err := database.CountResourceStatus(filter)
+	if err != nil {
+		logger.Log.Error("统计资源状态失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query status stats"})
+		return
+	}
+	ecsCapacity, err := database.SumECSCapacity(filter)
+	if err != nil {
+		logger.Log.Error("统计 ECS 容量失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query ECS capacity stats"})
+		return
+	}
+	instanceTypes, err := database.CountECSDistribution("instance_type", filter)
+	if err != nil {
+		logger.Log.Error("统计 ECS 规格分布失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query ECS instance type stats"})
+		return
+	}
+	osNames, err := database.CountECSDistribution("os_name", filter)
+	if err != nil {
+		logger.Log.Error("统计 ECS 操作系统分布失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query ECS OS stats"})
+		return
+	}
+	dbEngines, err := database.CountDBEngineVersions(filter)
+	if err != nil {
+		logger.Log.Error("统计数据库引擎版本失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query DB engine stats"})
+		return
+	}
+
+	var total int64
+	for _, rec := range resources {
+		total += rec.Count
+	}
+
+	c.JSON(200, gin.H{
+		"total":            total,
+		"resources":        resources,
+		"status":           status,
+		"ecsCapacity":      ecsCapacity,
+		"ecsInstanceTypes": instanceTypes,
+		"ecsOS":            osNames,
+		"dbEngines":        dbEngines,
+	})
+}
+
+// 单个分组的统计时间序列
+type StatsSeries struct {
+	Key    string // 分组值（账户、区域或资源类型），不分组时为空
+	Points []database.StatsHistoryPoint
+}
+
+// 处理统计历史请求：按天返回资源数、vCPU、内存及存储的时间序列，用于趋势图及容量规划，存储不含 ECS 云盘
+// 支持 account=<账户>、region=<区域>、type=<资源类型>、from=<开始日期>、to=<结束日期> 筛选，group_by=account|region|type 分组
+func handleStatsHistory(c *gin.Context) {
+	filter := database.StatsFilter{
+		CloudName:    c.Query("account"),
+		RegionID:     c.Query("region"),
+		ResourceType: c.Query("type"),
+	}
+	groupBy := c.Query("group_by")
+	switch groupBy {
+	case "", "account", "region", "type":
+	default:
+		c.JSON(400, gin.H{"error": "group_by must be one of account, region, type"})
+		return
+	}
+
+	points, err := database.ListStatsHistory(filter, groupBy, c.Query("from"), c.Query("to"))
+	if err != nil {
+		logger.Log.Error("查询统计历史数据失败: ", err)
+		c.JSON(500, gin.H{"error": "failed to query stats history"})
+		return
+	}
+
+	// 查询结果已按分组值、日期排序，相邻同组数据点合并为一条序列
+	series := []StatsSeries{}
+	for _, p := range points {
+		if len(series) == 0 || series[len(series)-1].Key != p.Key {
+			series = append(series, StatsSeries{Key: p.Key})
+		}
+		last := &series[len(series)-1]
+		last.Points = append(last.Points, p)
+	}
+
+	c.JSON(200, gin.H{"data": series, "total": len(series)})
+}
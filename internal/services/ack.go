@@ -0,0 +1,230 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+	"github.com/WillemCode/AliCloud_Resources/pkg/logger"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/cs"
+)
+
+// ACK 集群列表响应（SDK 未定义该接口的响应字段，需自行解析）
+type ackClusterList struct {
+	Clusters []struct {
+		ClusterID       string `json:"cluster_id"`
+		Name            string `json:"name"`
+		ClusterType     string `json:"cluster_type"`
+		ClusterSpec     string `json:"cluster_spec"`
+		Profile         string `json:"profile"`
+		CurrentVersion  string `json:"current_version"`
+		State           string `json:"state"`
+		RegionID        string `json:"region_id"`
+		ZoneID          string `json:"zone_id"`
+		VPCID           string `json:"vpc_id"`
+		VSwitchID       string `json:"vswitch_id"`
+		SecurityGroupID string `json:"security_group_id"`
+		MasterURL       string `json:"master_url"` // JSON 字符串，包含 API Server 地址
+		Created         string `json:"created"`
+	} `json:"clusters"`
+	PageInfo struct {
+		TotalCount int `json:"total_count"`
+	} `json:"page_info"`
+}
+
+// ACK 集群 API Server 地址
+type ackMasterURL struct {
+	APIServerEndpoint         string `json:"api_server_endpoint"`
+	IntranetAPIServerEndpoint string `json:"intranet_api_server_endpoint"`
+}
+
+// ACK 节点池列表响应（SDK 未定义该接口的响应字段，需自行解析）
+type ackNodePoolList struct {
+	NodePools []struct {
+		NodePoolInfo struct {
+			NodePoolID string `json:"nodepool_id"`
+			Name       string `json:"name"`
+			Type       string `json:"type"`
+			IsDefault  bool   `json:"is_default"`
+			Created    string `json:"created"`
+		} `json:"nodepool_info"`
+		ScalingGroup struct {
+			InstanceTypes  []string `json:"instance_types"`
+			VSwitchIDs     []string `json:"vswitch_ids"`
+			ScalingGroupID string   `json:"scaling_group_id"`
+			DesiredSize    int64    `json:"desired_size"`
+		} `json:"scaling_group"`
+		Status struct {
+			TotalNodes   int64  `json:"total_nodes"`
+			HealthyNodes int64  `json:"healthy_nodes"`
+			State        string `json:"state"`
+		} `json:"status"`
+	} `json:"nodepools"`
+}
+
+// SyncACKInfo 同步指定账户和区域的 ACK 集群、节点池及节点归属
+func SyncACKInfo(accountName string, ackRegionIds []string, accessKey string, accessSecret string) error {
+	for _, regionID := range ackRegionIds {
+		if regionID != "nil" && regionID != "" {
+			logger.Log.Infof("开始同步信息, 区域=%s, 资源=ACK, 账户=%s", regionID, accountName)
+
+			// 初始化容器服务客户端
+			client, err := cs.NewClientWithAccessKey(regionID, accessKey, accessSecret)
+			if err != nil {
+				return fmt.Errorf("ACK 客户端初始化失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+			}
+
+			// 分页请求数据
+			var records []database.ACKClusterRecord
+			pageSize := 50  // 每页返回的条数
+			pageNumber := 1 // 从第一页开始
+			totalCount := 0 // 总条数
+			for {
+				request := cs.CreateDescribeClustersV1Request()
+				request.QueryParams["region_id"] = regionID
+				request.PageSize = requests.NewInteger(pageSize)
+				request.PageNumber = requests.NewInteger(pageNumber)
+
+				response, err := client.DescribeClustersV1(request)
+				if err != nil {
+					return fmt.Errorf("ACK API 调用失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+				}
+				var result ackClusterList
+				if err := json.Unmarshal(response.GetHttpContentBytes(), &result); err != nil {
+					return fmt.Errorf("解析 ACK 集群列表失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+				}
+
+				// 获取总数
+				if totalCount == 0 {
+					totalCount = result.PageInfo.TotalCount
+					logger.Log.Infof("数据查询完成, 区域=%s, 资源=ACK, 账户=%s, 总数=%d 条", regionID, accountName, totalCount)
+				}
+
+				for _, cluster := range result.Clusters {
+					var masterURL ackMasterURL
+					if cluster.MasterURL != "" {
+						// 地址解析失败不影响集群本身的同步
+						if err := json.Unmarshal([]byte(cluster.MasterURL), &masterURL); err != nil {
+							logger.Log.Warnf("解析 ACK 集群 API Server 地址失败 (ClusterID=%s): %v", cluster.ClusterID, err)
+						}
+					}
+					records = append(records, database.ACKClusterRecord{
+						ClusterID:                 cluster.ClusterID,
+						CloudName:                 accountName,
+						Name:                      cluster.Name,
+						ClusterType:               cluster.ClusterType,
+						ClusterSpec:               cluster.ClusterSpec,
+						Profile:                   cluster.Profile,
+						Version:                   cluster.CurrentVersion,
+						State:                     cluster.State,
+						RegionID:                  cluster.RegionID,
+						ZoneID:                    cluster.ZoneID,
+						VPCID:                     cluster.VPCID,
+						VSwitchID:                 cluster.VSwitchID,
+						SecurityGroupID:           cluster.SecurityGroupID,
+						APIServerEndpoint:         masterURL.APIServerEndpoint,
+						IntranetAPIServerEndpoint: masterURL.IntranetAPIServerEndpoint,
+						CreateTime:                cluster.Created,
+					})
+				}
+				// 如果返回的数据条数小于 pageSize，说明已经拉取到最后一页，退出循环
+				if len(result.Clusters) < pageSize {
+					break
+				}
+
+				// 请求下一页数据
+				pageNumber++
+			}
+
+			// 保存 ACK 集群数据
+			if err := database.SaveACKClusterRecords(accountName, regionID, records); err != nil {
+				return fmt.Errorf("保存 ACK 数据失败 (账户=%s): %w", accountName, err)
+			}
+
+			// 逐个集群同步节点池及节点
+			for _, rec := range records {
+				if err := syncACKClusterDetails(client, rec.ClusterID); err != nil {
+					return fmt.Errorf("同步 ACK 节点池失败 (账户=%s): %w", accountName, err)
+				}
+			}
+
+			logger.Log.Infof("数据同步完成, 区域=%s, 资源=ACK, 账户=%s, 同步=%d 条", regionID, accountName, len(records))
+		} else {
+			logger.Log.Warnf("当前阿里账户, 区域=%s, 资源=ACK, 账户=%s, 暂无可用区域。", regionID, accountName)
+		}
+	}
+	return nil
+}
+
+// syncACKClusterDetails 同步单个集群的节点池，以及节点与 ECS 实例的对应关系
+func syncACKClusterDetails(client *cs.Client, clusterID string) error {
+	// 1) 节点池
+	poolRequest := cs.CreateDescribeClusterNodePoolsRequest()
+	poolRequest.ClusterId = clusterID
+	poolResponse, err := client.DescribeClusterNodePools(poolRequest)
+	if err != nil {
+		return fmt.Errorf("获取 ACK 节点池失败 (ClusterID=%s): %w", clusterID, err)
+	}
+	var poolResult ackNodePoolList
+	if err := json.Unmarshal(poolResponse.GetHttpContentBytes(), &poolResult); err != nil {
+		return fmt.Errorf("解析 ACK 节点池失败 (ClusterID=%s): %w", clusterID, err)
+	}
+	var nodePools []database.ACKNodePoolRecord
+	for _, pool := range poolResult.NodePools {
+		nodePools = append(nodePools, database.ACKNodePoolRecord{
+			NodePoolID:     pool.NodePoolInfo.NodePoolID,
+			ClusterID:      clusterID,
+			Name:           pool.NodePoolInfo.Name,
+			Type:           pool.NodePoolInfo.Type,
+			IsDefault:      pool.NodePoolInfo.IsDefault,
+			InstanceTypes:  strings.Join(pool.ScalingGroup.InstanceTypes, ","),
+			VSwitchIDs:     strings.Join(pool.ScalingGroup.VSwitchIDs, ","),
+			ScalingGroupID: pool.ScalingGroup.ScalingGroupID,
+			DesiredSize:    pool.ScalingGroup.DesiredSize,
+			TotalNodes:     pool.Status.TotalNodes,
+			HealthyNodes:   pool.Status.HealthyNodes,
+			State:          pool.Status.State,
+			CreateTime:     pool.NodePoolInfo.Created,
+		})
+	}
+
+	// 2) 节点（分页）
+	var nodes []database.ACKNodeRecord
+	pageSize := 100
+	pageNumber := 1
+	for {
+		request := cs.CreateDescribeClusterNodesRequest()
+		request.ClusterId = clusterID
+		request.PageSize = strconv.Itoa(pageSize)
+		request.PageNumber = strconv.Itoa(pageNumber)
+
+		response, err := client.DescribeClusterNodes(request)
+		if err != nil {
+			return fmt.Errorf("获取 ACK 节点失败 (ClusterID=%s): %w", clusterID, err)
+		}
+		for _, node := range response.Nodes {
+			// 托管集群的 Master 节点及非 ECS 节点没有实例ID
+			if node.InstanceId == "" {
+				continue
+			}
+			nodes = append(nodes, database.ACKNodeRecord{
+				InstanceID:   node.InstanceId,
+				ClusterID:    clusterID,
+				NodePoolID:   node.NodepoolId,
+				NodeName:     node.NodeName,
+				InstanceRole: node.InstanceRole,
+				State:        node.State,
+			})
+		}
+		if len(response.Nodes) < pageSize {
+			break
+		}
+		pageNumber++
+	}
+
+	return database.SaveACKClusterDetails(clusterID, nodePools, nodes)
+}
@@ -0,0 +1,164 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+	"github.com/WillemCode/AliCloud_Resources/pkg/logger"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/cr_ee"
+)
+
+// SyncACRInfo 同步指定账户和区域的容器镜像服务企业版实例、命名空间及镜像仓库
+func SyncACRInfo(accountName string, acrRegionIds []string, accessKey string, accessSecret string) error {
+	for _, regionID := range acrRegionIds {
+		if regionID != "nil" && regionID != "" {
+			logger.Log.Infof("开始同步信息, 区域=%s, 资源=ACR, 账户=%s", regionID, accountName)
+
+			// 初始化 ACR 客户端
+			client, err := cr_ee.NewClientWithAccessKey(regionID, accessKey, accessSecret)
+			if err != nil {
+				return fmt.Errorf("ACR 客户端初始化失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+			}
+
+			// 分页请求数据
+			var records []database.ACRInstanceRecord
+			pageSize := 50  // 每页返回的条数
+			pageNumber := 1 // 从第一页开始
+			totalCount := 0 // 总条数
+			for {
+				request := cr_ee.CreateListInstanceRequest()
+				request.RegionId = regionID
+				request.PageSize = requests.NewInteger(pageSize)
+				request.PageNo = requests.NewInteger(pageNumber)
+
+				response, err := client.ListInstance(request)
+				if err != nil {
+					return fmt.Errorf("ACR API 调用失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+				}
+
+				// 获取总数
+				if totalCount == 0 {
+					totalCount = response.TotalCount
+					logger.Log.Infof("数据查询完成, 区域=%s, 资源=ACR, 账户=%s, 总数=%d 条", regionID, accountName, totalCount)
+				}
+
+				for _, instance := range response.Instances {
+					records = append(records, database.ACRInstanceRecord{
+						InstanceID:    instance.InstanceId,
+						CloudName:     accountName,
+						RegionID:      instance.RegionId,
+						InstanceName:  instance.InstanceName,
+						Specification: instance.InstanceSpecification,
+						Status:        instance.InstanceStatus,
+						CreateTime:    instance.CreateTime,
+						ModifyTime:    instance.ModifiedTime,
+					})
+				}
+				// 如果返回的数据条数小于 pageSize，说明已经拉取到最后一页，退出循环
+				if len(response.Instances) < pageSize {
+					break
+				}
+
+				// 请求下一页数据
+				pageNumber++
+			}
+
+			// 保存实例数据
+			if err := database.SaveACRInstanceRecords(accountName, regionID, records); err != nil {
+				return fmt.Errorf("保存 ACR 数据失败 (账户=%s): %w", accountName, err)
+			}
+
+			// 逐个实例同步命名空间及镜像仓库
+			for _, rec := range records {
+				namespaces, err := listACRNamespaces(client, rec.InstanceID)
+				if err != nil {
+					return err
+				}
+				repositories, err := listACRRepositories(client, rec.InstanceID)
+				if err != nil {
+					return err
+				}
+				if err := database.SaveACRInstanceDetails(rec.InstanceID, namespaces, repositories); err != nil {
+					return fmt.Errorf("保存 ACR 实例详情失败 (账户=%s): %w", accountName, err)
+				}
+			}
+
+			logger.Log.Infof("数据同步完成, 区域=%s, 资源=ACR, 账户=%s, 同步=%d 条", regionID, accountName, len(records))
+		} else {
+			logger.Log.Warnf("当前阿里账户, 区域=%s, 资源=ACR, 账户=%s, 暂无可用区域。", regionID, accountName)
+		}
+	}
+	return nil
+}
+
+// listACRNamespaces 分页查询实例下的命名空间
+func listACRNamespaces(client *cr_ee.Client, instanceID string) ([]database.ACRNamespaceRecord, error) {
+	var records []database.ACRNamespaceRecord
+	pageSize := 50
+	pageNumber := 1
+	for {
+		request := cr_ee.CreateListNamespaceRequest()
+		request.InstanceId = instanceID
+		request.PageSize = requests.NewInteger(pageSize)
+		request.PageNo = requests.NewInteger(pageNumber)
+
+		response, err := client.ListNamespace(request)
+		if err != nil {
+			return nil, fmt.Errorf("获取 ACR 命名空间失败 (InstanceID=%s): %w", instanceID, err)
+		}
+		for _, namespace := range response.Namespaces {
+			records = append(records, database.ACRNamespaceRecord{
+				NamespaceID:     namespace.NamespaceId,
+				InstanceID:      instanceID,
+				NamespaceName:   namespace.NamespaceName,
+				Status:          namespace.NamespaceStatus,
+				DefaultRepoType: namespace.DefaultRepoType,
+				AutoCreateRepo:  namespace.AutoCreateRepo,
+			})
+		}
+		if len(response.Namespaces) < pageSize {
+			break
+		}
+		pageNumber++
+	}
+	return records, nil
+}
+
+// listACRRepositories 分页查询实例下的镜像仓库
+func listACRRepositories(client *cr_ee.Client, instanceID string) ([]database.ACRRepositoryRecord, error) {
+	var records []database.ACRRepositoryRecord
+	pageSize := 100
+	pageNumber := 1
+	for {
+		request := cr_ee.CreateListRepositoryRequest()
+		request.InstanceId = instanceID
+		request.PageSize = requests.NewInteger(pageSize)
+		request.PageNo = requests.NewInteger(pageNumber)
+
+		response, err := client.ListRepository(request)
+		if err != nil {
+			return nil, fmt.Errorf("获取 ACR 镜像仓库失败 (InstanceID=%s): %w", instanceID, err)
+		}
+		for _, repo := range response.Repositories {
+			records = append(records, database.ACRRepositoryRecord{
+				RepoID:        repo.RepoId,
+				InstanceID:    instanceID,
+				NamespaceName: repo.RepoNamespaceName,
+				RepoName:      repo.RepoName,
+				RepoType:      repo.RepoType,
+				BuildType:     repo.RepoBuildType,
+				Status:        repo.RepoStatus,
+				Summary:       repo.Summary,
+				CreateTime:    formatMillis(repo.CreateTime),
+				ModifyTime:    formatMillis(repo.ModifiedTime),
+			})
+		}
+		if len(response.Repositories) < pageSize {
+			break
+		}
+		pageNumber++
+	}
+	return records, nil
+}
@@ -0,0 +1,334 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+	"github.com/WillemCode/AliCloud_Resources/pkg/logger"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/alb"
+)
+
+// SyncALBInfo 同步指定账户和区域的 ALB 实例、监听、转发规则及服务器组
+func SyncALBInfo(accountName string, slbRegionIds []string, accessKey string, accessSecret string) error {
+	for _, regionID := range slbRegionIds {
+		if regionID != "nil" && regionID != "" {
+			logger.Log.Infof("开始同步信息, 区域=%s, 资源=ALB, 账户=%s", regionID, accountName)
+
+			// 初始化 ALB 客户端
+			client, err := alb.NewClientWithAccessKey(regionID, accessKey, accessSecret)
+			if err != nil {
+				return fmt.Errorf("ALB 客户端初始化失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+			}
+
+			// NextToken 分页请求数据
+			var records []database.LoadBalancerRecord
+			nextToken := ""
+			totalCount := 0
+			for {
+				request := alb.CreateListLoadBalancersRequest()
+				request.MaxResults = requests.NewInteger(100)
+				request.NextToken = nextToken
+
+				response, err := client.ListLoadBalancers(request)
+				if err != nil {
+					return fmt.Errorf("ALB API 调用失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+				}
+
+				// 获取总数
+				if totalCount == 0 {
+					totalCount = response.TotalCount
+					logger.Log.Infof("数据查询完成, 区域=%s, 资源=ALB, 账户=%s, 总数=%d 条", regionID, accountName, totalCount)
+				}
+
+				for _, lb := range response.LoadBalancers {
+					rec := database.LoadBalancerRecord{
+						InstanceID:       lb.LoadBalancerId,
+						LBType:           database.LBTypeALB,
+						CloudName:        accountName,
+						LoadBalancerName: lb.LoadBalancerName,
+						DNSName:          lb.DNSName,
+						AddressType:      lb.AddressType,
+						VPCID:            lb.VpcId,
+						RegionID:         regionID,
+						Status:           lb.LoadBalancerStatus,
+						Edition:          lb.LoadBalancerEdition,
+						CreateTime:       lb.CreateTime,
+					}
+					// 列表接口不返回可用区映射，需要逐个查询实例详情
+					if err := fillALBZoneMappings(client, &rec); err != nil {
+						return fmt.Errorf("获取 ALB 实例详情失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+					}
+					records = append(records, rec)
+				}
+
+				if response.NextToken == "" {
+					break
+				}
+				nextToken = response.NextToken
+			}
+
+			// 保存 ALB 数据
+			if err := database.SaveLoadBalancerRecords(accountName, regionID, database.LBTypeALB, records); err != nil {
+				return fmt.Errorf("保存 ALB 数据失败 (账户=%s): %w", accountName, err)
+			}
+
+			// 逐个实例同步监听、转发规则及服务器组
+			for _, rec := range records {
+				if err := syncALBListeners(client, rec.InstanceID); err != nil {
+					return fmt.Errorf("同步 ALB 监听失败 (账户=%s): %w", accountName, err)
+				}
+			}
+
+			logger.Log.Infof("数据同步完成, 区域=%s, 资源=ALB, 账户=%s, 同步=%d 条", regionID, accountName, len(records))
+		} else {
+			logger.Log.Warnf("当前阿里账户, 区域=%s, 资源=ALB, 账户=%s, 暂无可用区域。", regionID, accountName)
+		}
+	}
+	return nil
+}
+
+// fillALBZoneMappings 查询 ALB 实例详情，补充可用区、交换机及服务地址
+func fillALBZoneMappings(client *alb.Client, rec *database.LoadBalancerRecord) error {
+	request := alb.CreateGetLoadBalancerAttributeRequest()
+	request.LoadBalancerId = rec.InstanceID
+	response, err := client.GetLoadBalancerAttribute(request)
+	if err != nil {
+		return err
+	}
+
+	var zones, vswitches, addresses []string
+	for _, zm := range response.ZoneMappings {
+		zones = append(zones, zm.ZoneId)
+		vswitches = append(vswitches, zm.VSwitchId)
+		for _, addr := range zm.LoadBalancerAddresses {
+			for _, ip := range []string{addr.Address, addr.IntranetAddress, addr.Ipv6Address} {
+				if ip != "" {
+					addresses = append(addresses, ip)
+				}
+			}
+		}
+	}
+	rec.Zones = strings.Join(zones, ",")
+	rec.VSwitchID = strings.Join(vswitches, ",")
+	rec.Addresses = strings.Join(addresses, ",")
+	return nil
+}
+
+// syncALBListeners 同步单个 ALB 实例的监听、转发规则，以及被引用的服务器组
+func syncALBListeners(client *alb.Client, lbID string) error {
+	// 记录监听及规则引用到的服务器组
+	groupIDs := map[string]bool{}
+
+	// 1) 监听列表
+	var listeners []database.LBListenerRecord
+	nextToken := ""
+	for {
+		request := alb.CreateListListenersRequest()
+		request.LoadBalancerIds = &[]string{lbID}
+		request.MaxResults = requests.NewInteger(100)
+		request.NextToken = nextToken
+
+		response, err := client.ListListeners(request)
+		if err != nil {
+			return fmt.Errorf("获取 ALB 监听失败 (LoadBalancerID=%s): %w", lbID, err)
+		}
+		for _, l := range response.Listeners {
+			var ids []string
+			for _, action := range l.DefaultActions {
+				for _, tuple := range action.ForwardGroupConfig.ServerGroupTuples {
+					ids = append(ids, tuple.ServerGroupId)
+					groupIDs[tuple.ServerGroupId] = true
+				}
+			}
+			// HTTPS/QUIC 监听的证书需要单独查询
+			var certificateIDs []string
+			if l.ListenerProtocol == "HTTPS" || l.ListenerProtocol == "QUIC" {
+				certificateIDs, err = listALBListenerCertificates(client, l.ListenerId)
+				if err != nil {
+					return err
+				}
+			}
+			listeners = append(listeners, database.LBListenerRecord{
+				ListenerID:    l.ListenerId,
+				LBType:        database.LBTypeALB,
+				LBID:          lbID,
+				ListenerPort:  int64(l.ListenerPort),
+				Protocol:      l.ListenerProtocol,
+				ServerGroupID: strings.Join(ids, ","),
+				Status:        l.ListenerStatus,
+				CertificateID: strings.Join(certificateIDs, ","),
+				Description:   l.ListenerDescription,
+			})
+		}
+		if response.NextToken == "" {
+			break
+		}
+		nextToken = response.NextToken
+	}
+	if err := database.SaveLBListeners(lbID, listeners); err != nil {
+		return err
+	}
+
+	// 2) 转发规则
+	var rules []database.LBRuleRecord
+	nextToken = ""
+	for {
+		request := alb.CreateListRulesRequest()
+		request.LoadBalancerIds = &[]string{lbID}
+		request.MaxResults = requests.NewInteger(100)
+		request.NextToken = nextToken
+
+		response, err := client.ListRules(request)
+		if err != nil {
+			return fmt.Errorf("获取 ALB 转发规则失败 (LoadBalancerID=%s): %w", lbID, err)
+		}
+		for _, r := range response.Rules {
+			var ids []string
+			for _, action := range r.RuleActions {
+				for _, tuple := range action.ForwardGroupConfig.ServerGroupTuples {
+					ids = append(ids, tuple.ServerGroupId)
+					groupIDs[tuple.ServerGroupId] = true
+				}
+			}
+			rules = append(rules, database.LBRuleRecord{
+				RuleID:         r.RuleId,
+				ListenerID:     r.ListenerId,
+				RuleName:       r.RuleName,
+				Priority:       int64(r.Priority),
+				Conditions:     albRuleConditions(r.RuleConditions),
+				ServerGroupIDs: strings.Join(ids, ","),
+				Status:         r.RuleStatus,
+			})
+		}
+		if response.NextToken == "" {
+			break
+		}
+		nextToken = response.NextToken
+	}
+	if err := database.SaveLBRules(lbID, rules); err != nil {
+		return err
+	}
+
+	// 3) 服务器组及后端服务器
+	if err := database.DeleteLBServerGroups(lbID); err != nil {
+		return err
+	}
+	if len(groupIDs) == 0 {
+		return nil
+	}
+	var ids []string
+	for id := range groupIDs {
+		ids = append(ids, id)
+	}
+
+	nextToken = ""
+	for {
+		request := alb.CreateListServerGroupsRequest()
+		request.ServerGroupIds = &ids
+		request.MaxResults = requests.NewInteger(100)
+		request.NextToken = nextToken
+
+		response, err := client.ListServerGroups(request)
+		if err != nil {
+			return fmt.Errorf("获取 ALB 服务器组失败 (LoadBalancerID=%s): %w", lbID, err)
+		}
+		for _, group := range response.ServerGroups {
+			servers, err := listALBServerGroupServers(client, group.ServerGroupId)
+			if err != nil {
+				return err
+			}
+			groupRec := database.LBServerGroupRecord{
+				ServerGroupID:   group.ServerGroupId,
+				LBType:          database.LBTypeALB,
+				LBID:            lbID,
+				ServerGroupName: group.ServerGroupName,
+				Protocol:        group.Protocol,
+			}
+			if err := database.SaveLBServerGroup(groupRec, servers); err != nil {
+				return err
+			}
+		}
+		if response.NextToken == "" {
+			break
+		}
+		nextToken = response.NextToken
+	}
+	return nil
+}
+
+// listALBServerGroupServers 查询 ALB 服务器组内的后端服务器
+func listALBServerGroupServers(client *alb.Client, serverGroupID string) ([]database.LBBackendServerRecord, error) {
+	var servers []database.LBBackendServerRecord
+	nextToken := ""
+	for {
+		request := alb.CreateListServerGroupServersRequest()
+		request.ServerGroupId = serverGroupID
+		request.MaxResults = requests.NewInteger(100)
+		request.NextToken = nextToken
+
+		response, err := client.ListServerGroupServers(request)
+		if err != nil {
+			return nil, fmt.Errorf("获取 ALB 后端服务器失败 (ServerGroupID=%s): %w", serverGroupID, err)
+		}
+		for _, server := range response.Servers {
+			servers = append(servers, database.LBBackendServerRecord{
+				ServerID:    server.ServerId,
+				ServerType:  server.ServerType,
+				ServerIP:    server.ServerIp,
+				Port:        int64(server.Port),
+				Weight:      int64(server.Weight),
+				Description: server.Description,
+			})
+		}
+		if response.NextToken == "" {
+			break
+		}
+		nextToken = response.NextToken
+	}
+	return servers, nil
+}
+
+// albRuleConditions 将 ALB 转发条件转换为便于阅读和搜索的文本，例如 "Host=a.com,b.com; Path=/api/*"
+func albRuleConditions(conditions []alb.Condition) string {
+	var parts []string
+	for _, cond := range conditions {
+		switch cond.Type {
+		case "Host":
+			parts = append(parts, "Host="+strings.Join(cond.HostConfig.Values, ","))
+		case "Path":
+			parts = append(parts, "Path="+strings.Join(cond.PathConfig.Values, ","))
+		default:
+			parts = append(parts, cond.Type)
+		}
+	}
+	return strings.Join(parts, "; ")
+}
+
+// listALBListenerCertificates 查询 ALB 监听绑定的服务器证书ID
+func listALBListenerCertificates(client *alb.Client, listenerID string) ([]string, error) {
+	var ids []string
+	nextToken := ""
+	for {
+		request := alb.CreateListListenerCertificatesRequest()
+		request.ListenerId = listenerID
+		request.CertificateType = "Server"
+		request.MaxResults = requests.NewInteger(100)
+		request.NextToken = nextToken
+
+		response, err := client.ListListenerCertificates(request)
+		if err != nil {
+			return nil, fmt.Errorf("获取 ALB 监听证书失败 (ListenerID=%s): %w", listenerID, err)
+		}
+		for _, cert := range response.Certificates {
+			ids = append(ids, cert.CertificateId)
+		}
+		if response.NextToken == "" {
+			break
+		}
+		nextToken = response.NextToken
+	}
+	return ids, nil
+}
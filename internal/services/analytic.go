@@ -0,0 +1,470 @@
+package services
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+	"github.com/WillemCode/AliCloud_Resources/pkg/logger"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/adb"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/clickhouse"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/elasticsearch"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/gpdb"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/hitsdb"
+)
+
+// endpointWithPort 拼接连接地址与端口，地址为空时返回空字符串
+func endpointWithPort(host string, port string) string {
+	if host == "" {
+		return ""
+	}
+	if port == "" || port == "0" {
+		return host
+	}
+	return host + ":" + port
+}
+
+// SyncElasticsearchInfo 同步指定账户和区域的 Elasticsearch 实例
+func SyncElasticsearchInfo(accountName string, bigDataRegionIds []string, accessKey string, accessSecret string) error {
+	for _, regionID := range bigDataRegionIds {
+		if regionID != "nil" && regionID != "" {
+			logger.Log.Infof("开始同步信息, 区域=%s, 资源=Elasticsearch, 账户=%s", regionID, accountName)
+
+			// 初始化 Elasticsearch 客户端
+			client, err := elasticsearch.NewClientWithAccessKey(regionID, accessKey, accessSecret)
+			if err != nil {
+				return fmt.Errorf("Elasticsearch 客户端初始化失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+			}
+
+			// 分页请求数据
+			var records []database.AnalyticInstanceRecord
+			pageSize := 50  // 每页返回的条数
+			pageNumber := 1 // 从第一页开始
+			totalCount := 0 // 总条数
+			for {
+				request := elasticsearch.CreateListInstanceRequest()
+				request.Size = requests.NewInteger(pageSize)
+				request.Page = requests.NewInteger(pageNumber)
+
+				response, err := client.ListInstance(request)
+				if err != nil {
+					return fmt.Errorf("Elasticsearch API 调用失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+				}
+
+				// 获取总数
+				if totalCount == 0 {
+					totalCount = response.Headers.XTotalCount
+					logger.Log.Infof("数据查询完成, 区域=%s, 资源=Elasticsearch, 账户=%s, 总数=%d 条", regionID, accountName, totalCount)
+				}
+
+				for _, instance := range response.Result {
+					// 列表接口不返回访问地址，逐个实例查询
+					detailRequest := elasticsearch.CreateDescribeInstanceRequest()
+					detailRequest.InstanceId = instance.InstanceId
+					detail, err := client.DescribeInstance(detailRequest)
+					if err != nil {
+						return fmt.Errorf("获取 Elasticsearch 实例详情失败 (InstanceID=%s): %w", instance.InstanceId, err)
+					}
+
+					records = append(records, database.AnalyticInstanceRecord{
+						InstanceID:    instance.InstanceId,
+						Product:       database.AnalyticProductElasticsearch,
+						CloudName:     accountName,
+						Description:   instance.Description,
+						Engine:        "Elasticsearch",
+						EngineVersion: instance.EsVersion,
+						InstanceClass: instance.NodeSpec.Spec,
+						NodeCount:     int64(instance.NodeAmount),
+						StorageSize:   int64(instance.NodeSpec.Disk) * int64(instance.NodeAmount), // 接口返回单个数据节点的磁盘容量
+						Status:        instance.Status,
+						RegionID:      regionID,
+						ZoneID:        instance.NetworkConfig.VsArea,
+						VPCID:         instance.NetworkConfig.VpcId,
+						VSwitchID:     instance.NetworkConfig.VswitchId,
+						ConnectionString: joinUnique(endpointWithPort(detail.Result.Domain, strconv.Itoa(detail.Result.Port)),
+							endpointWithPort(detail.Result.PublicDomain, strconv.Itoa(detail.Result.PublicPort))),
+						CreateTime: instance.CreatedAt,
+					})
+				}
+				// 如果返回的数据条数小于 pageSize，说明已经拉取到最后一页，退出循环
+				if len(response.Result) < pageSize {
+					break
+				}
+
+				// 请求下一页数据
+				pageNumber++
+			}
+
+			// 保存 Elasticsearch 数据
+			if err := database.SaveAnalyticInstanceRecords(accountName, regionID, database.AnalyticProductElasticsearch, records); err != nil {
+				return fmt.Errorf("保存 Elasticsearch 数据失败 (账户=%s): %w", accountName, err)
+			}
+
+			logger.Log.Infof("数据同步完成, 区域=%s, 资源=Elasticsearch, 账户=%s, 同步=%d 条", regionID, accountName, len(records))
+		} else {
+			logger.Log.Warnf("当前阿里账户, 区域=%s, 资源=Elasticsearch, 账户=%s, 暂无可用区域。", regionID, accountName)
+		}
+	}
+	return nil
+}
+
+// SyncClickHouseInfo 同步指定账户和区域的 ClickHouse 集群
+func SyncClickHouseInfo(accountName string, bigDataRegionIds []string, accessKey string, accessSecret string) error {
+	for _, regionID := range bigDataRegionIds {
+		if regionID != "nil" && regionID != "" {
+			logger.Log.Infof("开始同步信息, 区域=%s, 资源=ClickHouse, 账户=%s", regionID, accountName)
+
+			// 初始化 ClickHouse 客户端
+			client, err := clickhouse.NewClientWithAccessKey(regionID, accessKey, accessSecret)
+			if err != nil {
+				return fmt.Errorf("ClickHouse 客户端初始化失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+			}
+
+			// 分页请求数据
+			var records []database.AnalyticInstanceRecord
+			pageSize := 50  // 每页返回的条数
+			pageNumber := 1 // 从第一页开始
+			totalCount := 0 // 总条数
+			for {
+				request := clickhouse.CreateDescribeDBClustersRequest()
+				request.RegionId = regionID
+				request.PageSize = requests.NewInteger(pageSize)
+				request.PageNumber = requests.NewInteger(pageNumber)
+
+				response, err := client.DescribeDBClusters(request)
+				if err != nil {
+					return fmt.Errorf("ClickHouse API 调用失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+				}
+
+				// 获取总数
+				if totalCount == 0 {
+					totalCount = response.TotalCount
+					logger.Log.Infof("数据查询完成, 区域=%s, 资源=ClickHouse, 账户=%s, 总数=%d 条", regionID, accountName, totalCount)
+				}
+
+				for _, cluster := range response.DBClusters.DBCluster {
+					records = append(records, database.AnalyticInstanceRecord{
+						InstanceID:       cluster.DBClusterId,
+						Product:          database.AnalyticProductClickHouse,
+						CloudName:        accountName,
+						Description:      cluster.DBClusterDescription,
+						Engine:           "ClickHouse",
+						EngineVersion:    cluster.EngineVersion,
+						InstanceClass:    cluster.DBNodeClass,
+						NodeCount:        cluster.DBNodeCount,
+						StorageSize:      cluster.DBNodeStorage * cluster.DBNodeCount, // 接口返回单节点存储
+						Status:           cluster.DBClusterStatus,
+						RegionID:         regionID,
+						ZoneID:           cluster.ZoneId,
+						VPCID:            cluster.VpcId,
+						VSwitchID:        cluster.VSwitchId,
+						ConnectionString: joinUnique(cluster.ConnectionString, cluster.PublicConnectionString),
+						CreateTime:       cluster.CreateTime,
+					})
+				}
+				// 如果返回的数据条数小于 pageSize，说明已经拉取到最后一页，退出循环
+				if len(response.DBClusters.DBCluster) < pageSize {
+					break
+				}
+
+				// 请求下一页数据
+				pageNumber++
+			}
+
+			// 保存 ClickHouse 数据
+			if err := database.SaveAnalyticInstanceRecords(accountName, regionID, database.AnalyticProductClickHouse, records); err != nil {
+				return fmt.Errorf("保存 ClickHouse 数据失败 (账户=%s): %w", accountName, err)
+			}
+
+			logger.Log.Infof("数据同步完成, 区域=%s, 资源=ClickHouse, 账户=%s, 同步=%d 条", regionID, accountName, len(records))
+		} else {
+			logger.Log.Warnf("当前阿里账户, 区域=%s, 资源=ClickHouse, 账户=%s, 暂无可用区域。", regionID, accountName)
+		}
+	}
+	return nil
+}
+
+// SyncADBInfo 同步指定账户和区域的 AnalyticDB MySQL 版集群
+func SyncADBInfo(accountName string, bigDataRegionIds []string, accessKey string, accessSecret string) error {
+	for _, regionID := range bigDataRegionIds {
+		if regionID != "nil" && regionID != "" {
+			logger.Log.Infof("开始同步信息, 区域=%s, 资源=AnalyticDB MySQL, 账户=%s", regionID, accountName)
+
+			// 初始化 AnalyticDB MySQL 客户端
+			client, err := adb.NewClientWithAccessKey(regionID, accessKey, accessSecret)
+			if err != nil {
+				return fmt.Errorf("AnalyticDB MySQL 客户端初始化失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+			}
+
+			// 分页请求数据
+			var records []database.AnalyticInstanceRecord
+			pageSize := 50  // 每页返回的条数
+			pageNumber := 1 // 从第一页开始
+			totalCount := 0 // 总条数
+			for {
+				request := adb.CreateDescribeDBClustersRequest()
+				request.RegionId = regionID
+				request.PageSize = requests.NewInteger(pageSize)
+				request.PageNumber = requests.NewInteger(pageNumber)
+
+				response, err := client.DescribeDBClusters(request)
+				if err != nil {
+					return fmt.Errorf("AnalyticDB MySQL API 调用失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+				}
+
+				// 获取总数
+				if totalCount == 0 {
+					totalCount = response.TotalCount
+					logger.Log.Infof("数据查询完成, 区域=%s, 资源=AnalyticDB MySQL, 账户=%s, 总数=%d 条", regionID, accountName, totalCount)
+				}
+
+				for _, cluster := range response.Items.DBCluster {
+					// 弹性模式集群按计算/存储资源计费，规格取计算资源
+					instanceClass := cluster.DBNodeClass
+					if instanceClass == "" {
+						instanceClass = cluster.ComputeResource
+					}
+					records = append(records, database.AnalyticInstanceRecord{
+						InstanceID:       cluster.DBClusterId,
+						Product:          database.AnalyticProductADBMySQL,
+						CloudName:        accountName,
+						Description:      cluster.DBClusterDescription,
+						Engine:           "MySQL",
+						EngineVersion:    cluster.DBVersion,
+						InstanceClass:    instanceClass,
+						NodeCount:        cluster.DBNodeCount,
+						StorageSize:      cluster.DBNodeStorage * cluster.DBNodeCount, // 接口返回单节点存储
+						Status:           cluster.DBClusterStatus,
+						RegionID:         regionID,
+						ZoneID:           cluster.ZoneId,
+						VPCID:            cluster.VPCId,
+						VSwitchID:        cluster.VSwitchId,
+						ConnectionString: endpointWithPort(cluster.ConnectionString, cluster.Port),
+						CreateTime:       cluster.CreateTime,
+					})
+				}
+				// 如果返回的数据条数小于 pageSize，说明已经拉取到最后一页，退出循环
+				if len(response.Items.DBCluster) < pageSize {
+					break
+				}
+
+				// 请求下一页数据
+				pageNumber++
+			}
+
+			// 保存 AnalyticDB MySQL 数据
+			if err := database.SaveAnalyticInstanceRecords(accountName, regionID, database.AnalyticProductADBMySQL, records); err != nil {
+				return fmt.Errorf("保存 AnalyticDB MySQL 数据失败 (账户=%s): %w", accountName, err)
+			}
+
+			logger.Log.Infof("数据同步完成, 区域=%s, 资源=AnalyticDB MySQL, 账户=%s, 同步=%d 条", regionID, accountName, len(records))
+		} else {
+			logger.Log.Warnf("当前阿里账户, 区域=%s, 资源=AnalyticDB MySQL, 账户=%s, 暂无可用区域。", regionID, accountName)
+		}
+	}
+	return nil
+}
+
+// SyncGPDBInfo 同步指定账户和区域的 AnalyticDB PostgreSQL 版实例
+func SyncGPDBInfo(accountName string, bigDataRegionIds []string, accessKey string, accessSecret string) error {
+	for _, regionID := range bigDataRegionIds {
+		if regionID != "nil" && regionID != "" {
+			logger.Log.Infof("开始同步信息, 区域=%s, 资源=AnalyticDB PostgreSQL, 账户=%s", regionID, accountName)
+
+			// 初始化 AnalyticDB PostgreSQL 客户端
+			client, err := gpdb.NewClientWithAccessKey(regionID, accessKey, accessSecret)
+			if err != nil {
+				return fmt.Errorf("AnalyticDB PostgreSQL 客户端初始化失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+			}
+
+			// 分页请求数据
+			var records []database.AnalyticInstanceRecord
+			pageSize := 50  // 每页返回的条数
+			pageNumber := 1 // 从第一页开始
+			totalCount := 0 // 总条数
+			for {
+				request := gpdb.CreateDescribeDBInstancesRequest()
+				request.RegionId = regionID
+				request.PageSize = requests.NewInteger(pageSize)
+				request.PageNumber = requests.NewInteger(pageNumber)
+
+				response, err := client.DescribeDBInstances(request)
+				if err != nil {
+					return fmt.Errorf("AnalyticDB PostgreSQL API 调用失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+				}
+
+				// 获取总数
+				if totalCount == 0 {
+					totalCount = response.TotalRecordCount
+					logger.Log.Infof("数据查询完成, 区域=%s, 资源=AnalyticDB PostgreSQL, 账户=%s, 总数=%d 条", regionID, accountName, totalCount)
+				}
+
+				for _, instance := range response.Items.DBInstance {
+					rec := database.AnalyticInstanceRecord{
+						InstanceID:    instance.DBInstanceId,
+						Product:       database.AnalyticProductADBPostgreSQL,
+						CloudName:     accountName,
+						Description:   instance.DBInstanceDescription,
+						Engine:        "PostgreSQL",
+						EngineVersion: instance.EngineVersion,
+						Status:        instance.DBInstanceStatus,
+						RegionID:      regionID,
+						ZoneID:        instance.ZoneId,
+						VPCID:         instance.VpcId,
+						VSwitchID:     instance.VSwitchId,
+						CreateTime:    instance.CreateTime,
+					}
+					rec.NodeCount, _ = strconv.ParseInt(instance.SegNodeNum, 10, 64)
+					rec.StorageSize, _ = strconv.ParseInt(instance.StorageSize, 10, 64)
+
+					// 列表接口不返回规格及连接地址，逐个实例查询
+					attrRequest := gpdb.CreateDescribeDBInstanceAttributeRequest()
+					attrRequest.DBInstanceId = instance.DBInstanceId
+					attrResponse, err := client.DescribeDBInstanceAttribute(attrRequest)
+					if err != nil {
+						return fmt.Errorf("获取 AnalyticDB PostgreSQL 实例详情失败 (InstanceID=%s): %w", instance.DBInstanceId, err)
+					}
+					for _, attr := range attrResponse.Items.DBInstanceAttribute {
+						rec.InstanceClass = attr.DBInstanceClass
+						rec.ConnectionString = endpointWithPort(attr.ConnectionString, attr.Port)
+					}
+
+					records = append(records, rec)
+				}
+				// 如果返回的数据条数小于 pageSize，说明已经拉取到最后一页，退出循环
+				if len(response.Items.DBInstance) < pageSize {
+					break
+				}
+
+				// 请求下一页数据
+				pageNumber++
+			}
+
+			// 保存 AnalyticDB PostgreSQL 数据
+			if err := database.SaveAnalyticInstanceRecords(accountName, regionID, database.AnalyticProductADBPostgreSQL, records); err != nil {
+				return fmt.Errorf("保存 AnalyticDB PostgreSQL 数据失败 (账户=%s): %w", accountName, err)
+			}
+
+			logger.Log.Infof("数据同步完成, 区域=%s, 资源=AnalyticDB PostgreSQL, 账户=%s, 同步=%d 条", regionID, accountName, len(records))
+		} else {
+			logger.Log.Warnf("当前阿里账户, 区域=%s, 资源=AnalyticDB PostgreSQL, 账户=%s, 暂无可用区域。", regionID, accountName)
+		}
+	}
+	return nil
+}
+
+// SyncLindormInfo 同步指定账户和区域的 Lindorm 实例
+func SyncLindormInfo(accountName string, bigDataRegionIds []string, accessKey string, accessSecret string) error {
+	for _, regionID := range bigDataRegionIds {
+		if regionID != "nil" && regionID != "" {
+			logger.Log.Infof("开始同步信息, 区域=%s, 资源=Lindorm, 账户=%s", regionID, accountName)
+
+			// 初始化 Lindorm 客户端
+			client, err := hitsdb.NewClientWithAccessKey(regionID, accessKey, accessSecret)
+			if err != nil {
+				return fmt.Errorf("Lindorm 客户端初始化失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+			}
+
+			// 分页请求数据
+			var records []database.AnalyticInstanceRecord
+			pageSize := 50  // 每页返回的条数
+			pageNumber := 1 // 从第一页开始
+			totalCount := 0 // 总条数
+			for {
+				request := hitsdb.CreateGetLindormInstanceListRequest()
+				request.RegionId = regionID
+				request.PageSize = requests.NewInteger(pageSize)
+				request.PageNumber = requests.NewInteger(pageNumber)
+
+				response, err := client.GetLindormInstanceList(request)
+				if err != nil {
+					return fmt.Errorf("Lindorm API 调用失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+				}
+
+				// 获取总数
+				if totalCount == 0 {
+					totalCount = response.Total
+					logger.Log.Infof("数据查询完成, 区域=%s, 资源=Lindorm, 账户=%s, 总数=%d 条", regionID, accountName, totalCount)
+				}
+
+				for _, instance := range response.InstanceList {
+					rec, err := lindormInstanceRecord(client, accountName, instance)
+					if err != nil {
+						return err
+					}
+					records = append(records, rec)
+				}
+				// 如果返回的数据条数小于 pageSize，说明已经拉取到最后一页，退出循环
+				if len(response.InstanceList) < pageSize {
+					break
+				}
+
+				// 请求下一页数据
+				pageNumber++
+			}
+
+			// 保存 Lindorm 数据
+			if err := database.SaveAnalyticInstanceRecords(accountName, regionID, database.AnalyticProductLindorm, records); err != nil {
+				return fmt.Errorf("保存 Lindorm 数据失败 (账户=%s): %w", accountName, err)
+			}
+
+			logger.Log.Infof("数据同步完成, 区域=%s, 资源=Lindorm, 账户=%s, 同步=%d 条", regionID, accountName, len(records))
+		} else {
+			logger.Log.Warnf("当前阿里账户, 区域=%s, 资源=Lindorm, 账户=%s, 暂无可用区域。", regionID, accountName)
+		}
+	}
+	return nil
+}
+
+// lindormInstanceRecord 查询 Lindorm 实例详情及各引擎连接地址
+func lindormInstanceRecord(client *hitsdb.Client, accountName string, instance hitsdb.LindormInstanceSummary) (database.AnalyticInstanceRecord, error) {
+	rec := database.AnalyticInstanceRecord{
+		InstanceID:  instance.InstanceId,
+		Product:     database.AnalyticProductLindorm,
+		CloudName:   accountName,
+		Description: instance.InstanceAlias,
+		Status:      instance.InstanceStatus,
+		RegionID:    instance.RegionId,
+		ZoneID:      instance.ZoneId,
+		VPCID:       instance.VpcId,
+		CreateTime:  instance.CreateTime,
+	}
+	rec.StorageSize, _ = strconv.ParseInt(instance.InstanceStorage, 10, 64)
+
+	// 实例详情：交换机、节点规格及各引擎版本
+	detailRequest := hitsdb.CreateGetLindormInstanceRequest()
+	detailRequest.InstanceId = instance.InstanceId
+	detail, err := client.GetLindormInstance(detailRequest)
+	if err != nil {
+		return rec, fmt.Errorf("获取 Lindorm 实例详情失败 (InstanceID=%s): %w", instance.InstanceId, err)
+	}
+	rec.VSwitchID = detail.VswitchId
+	rec.InstanceClass = detail.CoreSpec
+	rec.NodeCount = int64(detail.CoreNum)
+	var engines, versions []string
+	for _, engine := range detail.EngineList {
+		engines = append(engines, engine.Engine)
+		versions = append(versions, engine.Engine+"="+engine.Version)
+	}
+	rec.Engine = strings.Join(engines, ",")
+	rec.EngineVersion = strings.Join(versions, ",")
+
+	// 各引擎连接地址
+	engineRequest := hitsdb.CreateGetLindormInstanceEngineListRequest()
+	engineRequest.InstanceId = instance.InstanceId
+	engineResponse, err := client.GetLindormInstanceEngineList(engineRequest)
+	if err != nil {
+		return rec, fmt.Errorf("获取 Lindorm 连接地址失败 (InstanceID=%s): %w", instance.InstanceId, err)
+	}
+	var endpoints []string
+	for _, engine := range engineResponse.EngineList {
+		for _, netInfo := range engine.NetInfoList {
+			endpoints = append(endpoints, endpointWithPort(netInfo.ConnectionString, strconv.Itoa(netInfo.Port)))
+		}
+	}
+	rec.ConnectionString = joinUnique(endpoints...)
+	return rec, nil
+}
@@ -0,0 +1,104 @@
+package services
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+	"github.com/WillemCode/AliCloud_Resources/pkg/logger"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/bssopenapi"
+)
+
+// SyncBillingInfo 同步指定账户最近 billingMonths 个账期（含当月）的实例账单费用（全局服务，区域仅用于选择国内站/国际站接入点）
+func SyncBillingInfo(accountName string, bssRegionIds []string, accessKey string, accessSecret string, billingMonths int) error {
+	if billingMonths < 1 {
+		billingMonths = 1
+	}
+	for _, regionID := range bssRegionIds {
+		if regionID != "nil" && regionID != "" {
+			logger.Log.Infof("开始同步信息, 区域=%s, 资源=账单, 账户=%s", regionID, accountName)
+
+			// 初始化 BSS 客户端
+			client, err := bssopenapi.NewClientWithAccessKey(regionID, accessKey, accessSecret)
+			if err != nil {
+				return fmt.Errorf("BSS 客户端初始化失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+			}
+
+			now := time.Now()
+			for i := 0; i < billingMonths; i++ {
+				billingCycle := time.Date(now.Year(), now.Month()-time.Month(i), 1, 0, 0, 0, 0, now.Location()).Format("2006-01")
+				records, err := listInstanceBill(client, billingCycle)
+				if err != nil {
+					return fmt.Errorf("BSS API 调用失败 (账户=%s, 区域=%s, 账期=%s): %w", accountName, regionID, billingCycle, err)
+				}
+				if err := database.SaveCostRecords(accountName, billingCycle, records); err != nil {
+					return fmt.Errorf("保存账单数据失败 (账户=%s): %w", accountName, err)
+				}
+				logger.Log.Infof("数据同步完成, 区域=%s, 资源=账单, 账户=%s, 账期=%s, 同步=%d 条", regionID, accountName, billingCycle, len(records))
+			}
+		} else {
+			logger.Log.Warnf("当前阿里账户, 区域=%s, 资源=账单, 账户=%s, 暂无可用区域。", regionID, accountName)
+		}
+	}
+	return nil
+}
+
+// listInstanceBill 按 NextToken 分页查询账期内的实例账单，同一实例同一产品的多条明细合并为一条
+func listInstanceBill(client *bssopenapi.Client, billingCycle string) ([]database.CostRecord, error) {
+	var records []database.CostRecord
+	index := map[string]int{} // 实例ID+产品代码 -> records 下标
+	nextToken := ""
+	for {
+		request := bssopenapi.CreateDescribeInstanceBillRequest()
+		request.BillingCycle = billingCycle
+		request.Granularity = "MONTHLY"
+		request.IsBillingItem = requests.NewBoolean(false)
+		request.MaxResults = requests.NewInteger(300)
+		request.NextToken = nextToken
+
+		response, err := client.DescribeInstanceBill(request)
+		if err != nil {
+			return nil, err
+		}
+		if !response.Success {
+			return nil, fmt.Errorf("%s: %s", response.Code, response.Message)
+		}
+
+		for _, item := range response.Data.Items {
+			// 未关联实例的费用（如账户级资源包抵扣）无法归属到具体资源，跳过
+			if item.InstanceID == "" {
+				continue
+			}
+			key := item.InstanceID + "/" + item.ProductCode
+			if i, ok := index[key]; ok {
+				records[i].PretaxGrossAmount += item.PretaxGrossAmount
+				records[i].PretaxAmount += item.PretaxAmount
+				records[i].PaymentAmount += item.PaymentAmount
+				continue
+			}
+			index[key] = len(records)
+			records = append(records, database.CostRecord{
+				BillingCycle:      billingCycle,
+				InstanceID:        item.InstanceID,
+				ProductCode:       item.ProductCode,
+				ProductName:       item.ProductName,
+				ProductType:       item.ProductType,
+				SubscriptionType:  item.SubscriptionType,
+				Region:            item.Region,
+				ResourceGroup:     item.ResourceGroup,
+				Tag:               item.Tag,
+				PretaxGrossAmount: item.PretaxGrossAmount,
+				PretaxAmount:      item.PretaxAmount,
+				PaymentAmount:     item.PaymentAmount,
+				Currency:          item.Currency,
+			})
+		}
+		if response.Data.NextToken == "" {
+			break
+		}
+		nextToken = response.Data.NextToken
+	}
+	return records, nil
+}
@@ -0,0 +1,228 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+	"github.com/WillemCode/AliCloud_Resources/pkg/logger"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/cdn"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/dcdn"
+)
+
+// SyncCDNInfo 同步指定账户的 CDN 加速域名、源站及 HTTPS 证书（全局服务，区域仅用于选择接入点）
+func SyncCDNInfo(accountName string, cdnRegionIds []string, accessKey string, accessSecret string) error {
+	for _, regionID := range cdnRegionIds {
+		if regionID != "nil" && regionID != "" {
+			logger.Log.Infof("开始同步信息, 区域=%s, 资源=CDN, 账户=%s", regionID, accountName)
+
+			// 初始化 CDN 客户端
+			client, err := cdn.NewClientWithAccessKey(regionID, accessKey, accessSecret)
+			if err != nil {
+				return fmt.Errorf("CDN 客户端初始化失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+			}
+
+			// 分页请求数据
+			var records []database.CDNDomainRecord
+			origins := map[string][]database.CDNOriginRecord{}
+			pageSize := 50  // 每页返回的条数
+			pageNumber := 1 // 从第一页开始
+			totalCount := 0 // 总条数
+			for {
+				request := cdn.CreateDescribeUserDomainsRequest()
+				request.PageSize = requests.NewInteger(pageSize)
+				request.PageNumber = requests.NewInteger(pageNumber)
+
+				response, err := client.DescribeUserDomains(request)
+				if err != nil {
+					return fmt.Errorf("CDN API 调用失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+				}
+
+				// 获取总数
+				if totalCount == 0 {
+					totalCount = int(response.TotalCount)
+					logger.Log.Infof("数据查询完成, 区域=%s, 资源=CDN, 账户=%s, 总数=%d 条", regionID, accountName, totalCount)
+				}
+
+				for _, domain := range response.Domains.PageData {
+					rec := database.CDNDomainRecord{
+						DomainName:  domain.DomainName,
+						Product:     database.CDNProductCDN,
+						CloudName:   accountName,
+						CNAME:       domain.Cname,
+						CDNType:     domain.CdnType,
+						Status:      domain.DomainStatus,
+						Coverage:    domain.Coverage,
+						SSLProtocol: domain.SslProtocol,
+						Description: domain.Description,
+						CreateTime:  domain.GmtCreated,
+					}
+					if domain.SslProtocol == "on" {
+						fillCDNCertificate(client, &rec)
+					}
+					records = append(records, rec)
+
+					for _, source := range domain.Sources.Source {
+						origins[domain.DomainName] = append(origins[domain.DomainName], database.CDNOriginRecord{
+							OriginType: source.Type,
+							Content:    source.Content,
+							Port:       int64(source.Port),
+							Priority:   source.Priority,
+							Weight:     source.Weight,
+						})
+					}
+				}
+				// 如果返回的数据条数小于 pageSize，说明已经拉取到最后一页，退出循环
+				if len(response.Domains.PageData) < pageSize {
+					break
+				}
+
+				// 请求下一页数据
+				pageNumber++
+			}
+
+			// 保存数据
+			if err := saveCDNDomains(accountName, database.CDNProductCDN, records, origins); err != nil {
+				return err
+			}
+
+			logger.Log.Infof("数据同步完成, 区域=%s, 资源=CDN, 账户=%s, 同步=%d 条", regionID, accountName, len(records))
+		} else {
+			logger.Log.Warnf("当前阿里账户, 区域=%s, 资源=CDN, 账户=%s, 暂无可用区域。", regionID, accountName)
+		}
+	}
+	return nil
+}
+
+// fillCDNCertificate 查询 CDN 加速域名的 HTTPS 证书，失败时只记录日志
+func fillCDNCertificate(client *cdn.Client, rec *database.CDNDomainRecord) {
+	request := cdn.CreateDescribeDomainCertificateInfoRequest()
+	request.DomainName = rec.DomainName
+	response, err := client.DescribeDomainCertificateInfo(request)
+	if err != nil {
+		logger.Log.Warnf("获取 CDN 域名证书失败 (DomainName=%s): %v", rec.DomainName, err)
+		return
+	}
+	if len(response.CertInfos.CertInfo) > 0 {
+		cert := response.CertInfos.CertInfo[0]
+		rec.CertID = cert.CertId
+		rec.CertName = cert.CertName
+		rec.CertIssuer = cert.CertOrg
+		rec.CertExpireTime = cert.CertExpireTime
+	}
+}
+
+// SyncDCDNInfo 同步指定账户的全站加速 DCDN 域名、源站及 HTTPS 证书（全局服务，区域仅用于选择接入点）
+func SyncDCDNInfo(accountName string, cdnRegionIds []string, accessKey string, accessSecret string) error {
+	for _, regionID := range cdnRegionIds {
+		if regionID != "nil" && regionID != "" {
+			logger.Log.Infof("开始同步信息, 区域=%s, 资源=DCDN, 账户=%s", regionID, accountName)
+
+			// 初始化 DCDN 客户端
+			client, err := dcdn.NewClientWithAccessKey(regionID, accessKey, accessSecret)
+			if err != nil {
+				return fmt.Errorf("DCDN 客户端初始化失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+			}
+
+			// 分页请求数据
+			var records []database.CDNDomainRecord
+			origins := map[string][]database.CDNOriginRecord{}
+			pageSize := 50  // 每页返回的条数
+			pageNumber := 1 // 从第一页开始
+			totalCount := 0 // 总条数
+			for {
+				request := dcdn.CreateDescribeDcdnUserDomainsRequest()
+				request.PageSize = requests.NewInteger(pageSize)
+				request.PageNumber = requests.NewInteger(pageNumber)
+
+				response, err := client.DescribeDcdnUserDomains(request)
+				if err != nil {
+					return fmt.Errorf("DCDN API 调用失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+				}
+
+				// 获取总数
+				if totalCount == 0 {
+					totalCount = int(response.TotalCount)
+					logger.Log.Infof("数据查询完成, 区域=%s, 资源=DCDN, 账户=%s, 总数=%d 条", regionID, accountName, totalCount)
+				}
+
+				for _, domain := range response.Domains.PageData {
+					rec := database.CDNDomainRecord{
+						DomainName:  domain.DomainName,
+						Product:     database.CDNProductDCDN,
+						CloudName:   accountName,
+						CNAME:       domain.Cname,
+						CDNType:     domain.Scene,
+						Status:      domain.DomainStatus,
+						SSLProtocol: domain.SSLProtocol,
+						Description: domain.Description,
+						CreateTime:  domain.GmtCreated,
+					}
+					if domain.SSLProtocol == "on" {
+						fillDCDNCertificate(client, &rec)
+					}
+					records = append(records, rec)
+
+					for _, source := range domain.Sources.Source {
+						origins[domain.DomainName] = append(origins[domain.DomainName], database.CDNOriginRecord{
+							OriginType: source.Type,
+							Content:    source.Content,
+							Port:       int64(source.Port),
+							Priority:   source.Priority,
+							Weight:     source.Weight,
+						})
+					}
+				}
+				// 如果返回的数据条数小于 pageSize，说明已经拉取到最后一页，退出循环
+				if len(response.Domains.PageData) < pageSize {
+					break
+				}
+
+				// 请求下一页数据
+				pageNumber++
+			}
+
+			// 保存数据
+			if err := saveCDNDomains(accountName, database.CDNProductDCDN, records, origins); err != nil {
+				return err
+			}
+
+			logger.Log.Infof("数据同步完成, 区域=%s, 资源=DCDN, 账户=%s, 同步=%d 条", regionID, accountName, len(records))
+		} else {
+			logger.Log.Warnf("当前阿里账户, 区域=%s, 资源=DCDN, 账户=%s, 暂无可用区域。", regionID, accountName)
+		}
+	}
+	return nil
+}
+
+// fillDCDNCertificate 查询 DCDN 加速域名的 HTTPS 证书，失败时只记录日志
+func fillDCDNCertificate(client *dcdn.Client, rec *database.CDNDomainRecord) {
+	request := dcdn.CreateDescribeDcdnDomainCertificateInfoRequest()
+	request.DomainName = rec.DomainName
+	response, err := client.DescribeDcdnDomainCertificateInfo(request)
+	if err != nil {
+		logger.Log.Warnf("获取 DCDN 域名证书失败 (DomainName=%s): %v", rec.DomainName, err)
+		return
+	}
+	if len(response.CertInfos.CertInfo) > 0 {
+		cert := response.CertInfos.CertInfo[0]
+		rec.CertID = cert.CertId
+		rec.CertName = cert.CertName
+		rec.CertIssuer = cert.CertOrg
+		rec.CertExpireTime = cert.CertExpireTime
+	}
+}
+
+// saveCDNDomains 覆盖保存指定产品的加速域名及其源站
+func saveCDNDomains(accountName string, product string, records []database.CDNDomainRecord, origins map[string][]database.CDNOriginRecord) error {
+	if err := database.SaveCDNDomainRecords(accountName, product, records); err != nil {
+		return fmt.Errorf("保存 CDN 数据失败 (账户=%s): %w", accountName, err)
+	}
+	for _, rec := range records {
+		if err := database.SaveCDNOrigins(rec.DomainName, origins[rec.DomainName]); err != nil {
+			return fmt.Errorf("保存 CDN 源站数据失败 (账户=%s): %w", accountName, err)
+		}
+	}
+	return nil
+}
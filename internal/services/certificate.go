@@ -0,0 +1,137 @@
+package services
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+	"github.com/WillemCode/AliCloud_Resources/pkg/logger"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/cas"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
+)
+
+// SyncCASInfo 同步指定账户在数字证书管理服务中的证书（包括购买和上传的证书）
+func SyncCASInfo(accountName string, casRegionIds []string, accessKey string, accessSecret string) error {
+	for _, regionID := range casRegionIds {
+		if regionID != "nil" && regionID != "" {
+			logger.Log.Infof("开始同步信息, 区域=%s, 资源=CAS, 账户=%s", regionID, accountName)
+
+			// 初始化数字证书管理服务客户端
+			client, err := cas.NewClientWithAccessKey(regionID, accessKey, accessSecret)
+			if err != nil {
+				return fmt.Errorf("CAS 客户端初始化失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+			}
+
+			// 分页请求数据
+			var records []database.CertificateRecord
+			pageSize := 50  // 每页返回的条数
+			pageNumber := 1 // 从第一页开始
+			totalCount := 0 // 总条数
+			for {
+				request := cas.CreateListUserCertificateOrderRequest()
+				request.OrderType = "CERT" // 只查询证书，不查询订单
+				request.ShowSize = requests.NewInteger(pageSize)
+				request.CurrentPage = requests.NewInteger(pageNumber)
+
+				response, err := client.ListUserCertificateOrder(request)
+				if err != nil {
+					return fmt.Errorf("CAS API 调用失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+				}
+
+				// 获取总数
+				if totalCount == 0 {
+					totalCount = int(response.TotalCount)
+					logger.Log.Infof("数据查询完成, 区域=%s, 资源=CAS, 账户=%s, 总数=%d 条", regionID, accountName, totalCount)
+				}
+
+				for _, cert := range response.CertificateOrderList {
+					records = append(records, database.CertificateRecord{
+						CertID:      strconv.FormatInt(cert.CertificateId, 10),
+						Source:      database.CertSourceCAS,
+						CloudName:   accountName,
+						Name:        cert.Name,
+						CommonName:  cert.CommonName,
+						SANs:        cert.Sans,
+						Issuer:      cert.Issuer,
+						NotBefore:   formatMillis(cert.CertStartTime),
+						NotAfter:    formatMillis(cert.CertEndTime),
+						RegionID:    regionID,
+						Fingerprint: cert.Fingerprint,
+						Status:      cert.Status,
+					})
+				}
+				// 如果返回的数据条数小于 pageSize，说明已经拉取到最后一页，退出循环
+				if len(response.CertificateOrderList) < pageSize {
+					break
+				}
+
+				// 请求下一页数据
+				pageNumber++
+			}
+
+			// 保存数据
+			if err := database.SaveCertificateRecords(accountName, database.CertSourceCAS, regionID, records); err != nil {
+				return fmt.Errorf("保存 CAS 数据失败 (账户=%s): %w", accountName, err)
+			}
+
+			logger.Log.Infof("数据同步完成, 区域=%s, 资源=CAS, 账户=%s, 同步=%d 条", regionID, accountName, len(records))
+		} else {
+			logger.Log.Warnf("当前阿里账户, 区域=%s, 资源=CAS, 账户=%s, 暂无可用区域。", regionID, accountName)
+		}
+	}
+	return nil
+}
+
+// SyncSLBCertificateInfo 同步指定账户和区域上传到 CLB 的服务器证书
+func SyncSLBCertificateInfo(accountName string, slbRegionIds []string, accessKey string, accessSecret string) error {
+	for _, regionID := range slbRegionIds {
+		if regionID != "nil" && regionID != "" {
+			logger.Log.Infof("开始同步信息, 区域=%s, 资源=CLB证书, 账户=%s", regionID, accountName)
+
+			// 初始化 SLB 客户端
+			client, err := slb.NewClientWithAccessKey(regionID, accessKey, accessSecret)
+			if err != nil {
+				return fmt.Errorf("SLB 客户端初始化失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+			}
+
+			// 该接口不分页，一次返回区域内全部证书
+			request := slb.CreateDescribeServerCertificatesRequest()
+			request.RegionId = regionID
+			response, err := client.DescribeServerCertificates(request)
+			if err != nil {
+				return fmt.Errorf("CLB 证书 API 调用失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+			}
+			logger.Log.Infof("数据查询完成, 区域=%s, 资源=CLB证书, 账户=%s, 总数=%d 条", regionID, accountName,
+				len(response.ServerCertificates.ServerCertificate))
+
+			var records []database.CertificateRecord
+			for _, cert := range response.ServerCertificates.ServerCertificate {
+				records = append(records, database.CertificateRecord{
+					CertID:      cert.ServerCertificateId,
+					Source:      database.CertSourceSLB,
+					CloudName:   accountName,
+					Name:        cert.ServerCertificateName,
+					CommonName:  cert.CommonName,
+					SANs:        strings.Join(cert.SubjectAlternativeNames.SubjectAlternativeName, ","),
+					NotAfter:    formatMillis(cert.ExpireTimeStamp),
+					RegionID:    regionID,
+					CASCertID:   cert.AliCloudCertificateId,
+					Fingerprint: cert.Fingerprint,
+				})
+			}
+
+			// 保存数据
+			if err := database.SaveCertificateRecords(accountName, database.CertSourceSLB, regionID, records); err != nil {
+				return fmt.Errorf("保存 CLB 证书数据失败 (账户=%s): %w", accountName, err)
+			}
+
+			logger.Log.Infof("数据同步完成, 区域=%s, 资源=CLB证书, 账户=%s, 同步=%d 条", regionID, accountName, len(records))
+		} else {
+			logger.Log.Warnf("当前阿里账户, 区域=%s, 资源=CLB证书, 账户=%s, 暂无可用区域。", regionID, accountName)
+		}
+	}
+	return nil
+}
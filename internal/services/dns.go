@@ -0,0 +1,259 @@
+package services
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+	"github.com/WillemCode/AliCloud_Resources/pkg/logger"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/alidns"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/pvtz"
+)
+
+// dnsHostname 拼接主机记录和域名得到完整主机名，"@" 表示域名本身
+func dnsHostname(rr, zoneName string) string {
+	zoneName = strings.TrimSuffix(strings.ToLower(zoneName), ".")
+	if rr == "" || rr == "@" {
+		return zoneName
+	}
+	return strings.ToLower(rr) + "." + zoneName
+}
+
+// SyncDNSInfo 同步指定账户的云解析 DNS 域名及解析记录（全局服务，区域仅用于选择接入点）
+func SyncDNSInfo(accountName string, dnsRegionIds []string, accessKey string, accessSecret string) error {
+	for _, regionID := range dnsRegionIds {
+		if regionID != "nil" && regionID != "" {
+			logger.Log.Infof("开始同步信息, 区域=%s, 资源=DNS, 账户=%s", regionID, accountName)
+
+			// 初始化云解析客户端
+			client, err := alidns.NewClientWithAccessKey(regionID, accessKey, accessSecret)
+			if err != nil {
+				return fmt.Errorf("DNS 客户端初始化失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+			}
+
+			// 分页请求数据
+			var records []database.DNSZoneRecord
+			pageSize := 100 // 每页返回的条数
+			pageNumber := 1 // 从第一页开始
+			totalCount := 0 // 总条数
+			for {
+				request := alidns.CreateDescribeDomainsRequest()
+				request.PageSize = requests.NewInteger(pageSize)
+				request.PageNumber = requests.NewInteger(pageNumber)
+
+				response, err := client.DescribeDomains(request)
+				if err != nil {
+					return fmt.Errorf("DNS API 调用失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+				}
+
+				// 获取总数
+				if totalCount == 0 {
+					totalCount = int(response.TotalCount)
+					logger.Log.Infof("数据查询完成, 区域=%s, 资源=DNS, 账户=%s, 总数=%d 条", regionID, accountName, totalCount)
+				}
+
+				for _, domain := range response.Domains.Domain {
+					records = append(records, database.DNSZoneRecord{
+						ZoneID:      domain.DomainId,
+						ZoneType:    database.DNSZoneTypePublic,
+						CloudName:   accountName,
+						ZoneName:    domain.DomainName,
+						RecordCount: domain.RecordCount,
+						Version:     domain.VersionName,
+						Remark:      domain.Remark,
+						CreateTime:  domain.CreateTime,
+					})
+				}
+				// 如果返回的数据条数小于 pageSize，说明已经拉取到最后一页，退出循环
+				if len(response.Domains.Domain) < pageSize {
+					break
+				}
+
+				// 请求下一页数据
+				pageNumber++
+			}
+
+			// 保存域名数据
+			if err := database.SaveDNSZoneRecords(accountName, database.DNSZoneTypePublic, records); err != nil {
+				return fmt.Errorf("保存 DNS 数据失败 (账户=%s): %w", accountName, err)
+			}
+
+			// 逐个域名同步解析记录
+			for _, rec := range records {
+				dnsRecords, err := listDomainRecords(client, accountName, rec)
+				if err != nil {
+					return err
+				}
+				if err := database.SaveDNSRecords(rec.ZoneID, dnsRecords); err != nil {
+					return fmt.Errorf("保存 DNS 解析记录失败 (账户=%s): %w", accountName, err)
+				}
+			}
+
+			logger.Log.Infof("数据同步完成, 区域=%s, 资源=DNS, 账户=%s, 同步=%d 条", regionID, accountName, len(records))
+		} else {
+			logger.Log.Warnf("当前阿里账户, 区域=%s, 资源=DNS, 账户=%s, 暂无可用区域。", regionID, accountName)
+		}
+	}
+	return nil
+}
+
+// listDomainRecords 分页查询公网域名的解析记录
+func listDomainRecords(client *alidns.Client, accountName string, zone database.DNSZoneRecord) ([]database.DNSRecord, error) {
+	var records []database.DNSRecord
+	pageSize := 500
+	pageNumber := 1
+	for {
+		request := alidns.CreateDescribeDomainRecordsRequest()
+		request.DomainName = zone.ZoneName
+		request.PageSize = requests.NewInteger(pageSize)
+		request.PageNumber = requests.NewInteger(pageNumber)
+
+		response, err := client.DescribeDomainRecords(request)
+		if err != nil {
+			return nil, fmt.Errorf("获取 DNS 解析记录失败 (DomainName=%s): %w", zone.ZoneName, err)
+		}
+		for _, record := range response.DomainRecords.Record {
+			records = append(records, database.DNSRecord{
+				RecordID:  record.RecordId,
+				ZoneType:  database.DNSZoneTypePublic,
+				CloudName: accountName,
+				Hostname:  dnsHostname(record.RR, zone.ZoneName),
+				RR:        record.RR,
+				Type:      record.Type,
+				Value:     record.Value,
+				TTL:       record.TTL,
+				Line:      record.Line,
+				Status:    record.Status,
+				Remark:    record.Remark,
+			})
+		}
+		if len(response.DomainRecords.Record) < pageSize {
+			break
+		}
+		pageNumber++
+	}
+	return records, nil
+}
+
+// SyncPrivateZoneInfo 同步指定账户的内网 DNS（PrivateZone）及解析记录（全局服务，区域仅用于选择接入点）
+func SyncPrivateZoneInfo(accountName string, dnsRegionIds []string, accessKey string, accessSecret string) error {
+	for _, regionID := range dnsRegionIds {
+		if regionID != "nil" && regionID != "" {
+			logger.Log.Infof("开始同步信息, 区域=%s, 资源=PrivateZone, 账户=%s", regionID, accountName)
+
+			// 初始化 PrivateZone 客户端
+			client, err := pvtz.NewClientWithAccessKey(regionID, accessKey, accessSecret)
+			if err != nil {
+				return fmt.Errorf("PrivateZone 客户端初始化失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+			}
+
+			// 分页请求数据
+			var records []database.DNSZoneRecord
+			pageSize := 100 // 每页返回的条数
+			pageNumber := 1 // 从第一页开始
+			totalCount := 0 // 总条数
+			for {
+				request := pvtz.CreateDescribeZonesRequest()
+				request.PageSize = requests.NewInteger(pageSize)
+				request.PageNumber = requests.NewInteger(pageNumber)
+
+				response, err := client.DescribeZones(request)
+				if err != nil {
+					return fmt.Errorf("PrivateZone API 调用失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+				}
+
+				// 获取总数
+				if totalCount == 0 {
+					totalCount = response.TotalItems
+					logger.Log.Infof("数据查询完成, 区域=%s, 资源=PrivateZone, 账户=%s, 总数=%d 条", regionID, accountName, totalCount)
+				}
+
+				for _, zone := range response.Zones.Zone {
+					var vpcIDs []string
+					for _, vpc := range zone.Vpcs.Vpc {
+						vpcIDs = append(vpcIDs, vpc.VpcId)
+					}
+					records = append(records, database.DNSZoneRecord{
+						ZoneID:      zone.ZoneId,
+						ZoneType:    database.DNSZoneTypePrivate,
+						CloudName:   accountName,
+						ZoneName:    zone.ZoneName,
+						RecordCount: int64(zone.RecordCount),
+						VPCIDs:      strings.Join(vpcIDs, ","),
+						Remark:      zone.Remark,
+						CreateTime:  zone.CreateTime,
+					})
+				}
+				// 如果返回的数据条数小于 pageSize，说明已经拉取到最后一页，退出循环
+				if len(response.Zones.Zone) < pageSize {
+					break
+				}
+
+				// 请求下一页数据
+				pageNumber++
+			}
+
+			// 保存 Zone 数据
+			if err := database.SaveDNSZoneRecords(accountName, database.DNSZoneTypePrivate, records); err != nil {
+				return fmt.Errorf("保存 PrivateZone 数据失败 (账户=%s): %w", accountName, err)
+			}
+
+			// 逐个 Zone 同步解析记录
+			for _, rec := range records {
+				dnsRecords, err := listZoneRecords(client, accountName, rec)
+				if err != nil {
+					return err
+				}
+				if err := database.SaveDNSRecords(rec.ZoneID, dnsRecords); err != nil {
+					return fmt.Errorf("保存 PrivateZone 解析记录失败 (账户=%s): %w", accountName, err)
+				}
+			}
+
+			logger.Log.Infof("数据同步完成, 区域=%s, 资源=PrivateZone, 账户=%s, 同步=%d 条", regionID, accountName, len(records))
+		} else {
+			logger.Log.Warnf("当前阿里账户, 区域=%s, 资源=PrivateZone, 账户=%s, 暂无可用区域。", regionID, accountName)
+		}
+	}
+	return nil
+}
+
+// listZoneRecords 分页查询内网 Zone 的解析记录
+func listZoneRecords(client *pvtz.Client, accountName string, zone database.DNSZoneRecord) ([]database.DNSRecord, error) {
+	var records []database.DNSRecord
+	pageSize := 100
+	pageNumber := 1
+	for {
+		request := pvtz.CreateDescribeZoneRecordsRequest()
+		request.ZoneId = zone.ZoneID
+		request.PageSize = requests.NewInteger(pageSize)
+		request.PageNumber = requests.NewInteger(pageNumber)
+
+		response, err := client.DescribeZoneRecords(request)
+		if err != nil {
+			return nil, fmt.Errorf("获取 PrivateZone 解析记录失败 (ZoneID=%s): %w", zone.ZoneID, err)
+		}
+		for _, record := range response.Records.Record {
+			records = append(records, database.DNSRecord{
+				RecordID:  strconv.FormatInt(record.RecordId, 10),
+				ZoneType:  database.DNSZoneTypePrivate,
+				CloudName: accountName,
+				Hostname:  dnsHostname(record.Rr, zone.ZoneName),
+				RR:        record.Rr,
+				Type:      record.Type,
+				Value:     record.Value,
+				TTL:       int64(record.Ttl),
+				Line:      record.Line,
+				Status:    record.Status,
+				Remark:    record.Remark,
+			})
+		}
+		if len(response.Records.Record) < pageSize {
+			break
+		}
+		pageNumber++
+	}
+	return records, nil
+}
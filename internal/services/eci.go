@@ -0,0 +1,88 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+	"github.com/WillemCode/AliCloud_Resources/pkg/logger"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/eci"
+)
+
+// SyncECIInfo 同步指定账户和区域的弹性容器实例（ECI 容器组）
+func SyncECIInfo(accountName string, eciRegionIds []string, accessKey string, accessSecret string) error {
+	for _, regionID := range eciRegionIds {
+		if regionID != "nil" && regionID != "" {
+			logger.Log.Infof("开始同步信息, 区域=%s, 资源=ECI, 账户=%s", regionID, accountName)
+
+			// 初始化 ECI 客户端
+			client, err := eci.NewClientWithAccessKey(regionID, accessKey, accessSecret)
+			if err != nil {
+				return fmt.Errorf("ECI 客户端初始化失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+			}
+
+			// 使用 NextToken 分页请求数据
+			var records []database.ECIContainerGroupRecord
+			nextToken := ""
+			totalCount := 0
+			for {
+				request := eci.CreateDescribeContainerGroupsRequest()
+				request.RegionId = regionID
+				request.Limit = requests.NewInteger(20)
+				request.NextToken = nextToken
+
+				response, err := client.DescribeContainerGroups(request)
+				if err != nil {
+					return fmt.Errorf("ECI API 调用失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+				}
+
+				// 获取总数
+				if totalCount == 0 {
+					totalCount = response.TotalCount
+					logger.Log.Infof("数据查询完成, 区域=%s, 资源=ECI, 账户=%s, 总数=%d 条", regionID, accountName, totalCount)
+				}
+
+				for _, group := range response.ContainerGroups {
+					var containers []string
+					for _, container := range group.Containers {
+						containers = append(containers, container.Name+"="+container.Image)
+					}
+					records = append(records, database.ECIContainerGroupRecord{
+						ContainerGroupID: group.ContainerGroupId,
+						CloudName:        accountName,
+						Name:             group.ContainerGroupName,
+						RegionID:         group.RegionId,
+						ZoneID:           group.ZoneId,
+						Status:           group.Status,
+						CPU:              float64(group.Cpu),
+						Memory:           float64(group.Memory),
+						InstanceType:     group.InstanceType,
+						VPCID:            group.VpcId,
+						VSwitchID:        group.VSwitchId,
+						SecurityGroupID:  group.SecurityGroupId,
+						IntranetIP:       group.IntranetIp,
+						InternetIP:       group.InternetIp,
+						Containers:       strings.Join(containers, ","),
+						CreateTime:       group.CreationTime,
+					})
+				}
+				if response.NextToken == "" {
+					break
+				}
+				nextToken = response.NextToken
+			}
+
+			// 保存数据
+			if err := database.SaveECIContainerGroupRecords(accountName, regionID, records); err != nil {
+				return fmt.Errorf("保存 ECI 数据失败 (账户=%s): %w", accountName, err)
+			}
+
+			logger.Log.Infof("数据同步完成, 区域=%s, 资源=ECI, 账户=%s, 同步=%d 条", regionID, accountName, len(records))
+		} else {
+			logger.Log.Warnf("当前阿里账户, 区域=%s, 资源=ECI, 账户=%s, 暂无可用区域。", regionID, accountName)
+		}
+	}
+	return nil
+}
@@ -24,10 +24,11 @@ func SyncECSInfo(accountName string, ecsRegionIds []string, accessKey string, ac
 			}
 
 			// 分页请求数据
-			pageSize := 10                   // 每页返回的条数，转换为 int64 类型
-			pageNumber := 1                  // 从第一页开始，转换为 int64 类型
-			totalCount := 0                  // 总条数，初始化为 0，转换为 int64 类型
-			var records []database.ECSRecord // 存储 ECS 实例记录
+			pageSize := 10                                // 每页返回的条数，转换为 int64 类型
+			pageNumber := 1                               // 从第一页开始，转换为 int64 类型
+			totalCount := 0                               // 总条数，初始化为 0，转换为 int64 类型
+			var records []database.ECSRecord              // 存储 ECS 实例记录
+			var sgLinks []database.ECSSecurityGroupRecord // 存储 ECS 实例与安全组的关联
 			for {
 				// 构造请求
 				// 构造请求并获取 ECS 实例列表
@@ -97,8 +98,18 @@ func SyncECSInfo(accountName string, ecsRegionIds []string, accessKey string, ac
 						Memory:       int64(instance.Memory),
 						PublicIP:     publicIPs,
 						PrivateIP:    privateIP,
+						VPCID:        instance.VpcAttributes.VpcId,
+						VSwitchID:    instance.VpcAttributes.VSwitchId,
 					}
 					records = append(records, rec)
+
+					// 记录实例所属的安全组，用于暴露面分析
+					for _, sgID := range instance.SecurityGroupIds.SecurityGroupId {
+						sgLinks = append(sgLinks, database.ECSSecurityGroupRecord{
+							InstanceID:      instance.InstanceId,
+							SecurityGroupID: sgID,
+						})
+					}
 				}
 				// 如果返回的数据条数小于 pageSize，说明已经拉取到最后一页，退出循环
 				if len(response.Instances.Instance) < pageSize {
@@ -110,9 +121,12 @@ func SyncECSInfo(accountName string, ecsRegionIds []string, accessKey string, ac
 			}
 
 			// 调用数据库包保存 ECS 数据
-			if err := database.SaveECSRecords(records); err != nil {
+			if err := database.SaveECSRecords(accountName, regionID, records); err != nil {
 				return fmt.Errorf("保存 ECS 数据失败 (账户=%s): %w", accountName, err)
 			}
+			if err := database.SaveECSSecurityGroups(sgLinks); err != nil {
+				return fmt.Errorf("保存 ECS 安全组关联失败 (账户=%s): %w", accountName, err)
+			}
 			logger.Log.Infof("数据同步完成, 区域=%s, 资源=ECS, 账户=%s, 同步=%d 条", regionID, accountName, len(records))
 		} else {
 			logger.Log.Warnf("当前阿里账户, 区域=%s, 资源=ECS, 账户=%s, 暂无可用区域。", regionID, accountName)
@@ -0,0 +1,87 @@
+package services
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+	"github.com/WillemCode/AliCloud_Resources/pkg/logger"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+)
+
+// SyncEIPInfo 同步指定账户和区域的全部弹性公网 IP（包括未绑定的 EIP）
+func SyncEIPInfo(accountName string, vpcRegionIds []string, accessKey string, accessSecret string) error {
+	for _, regionID := range vpcRegionIds {
+		if regionID != "nil" && regionID != "" {
+			logger.Log.Infof("开始同步信息, 区域=%s, 资源=EIP, 账户=%s", regionID, accountName)
+
+			// EIP 属于 VPC 产品，复用 VPC 客户端
+			client, err := vpc.NewClientWithAccessKey(regionID, accessKey, accessSecret)
+			if err != nil {
+				return fmt.Errorf("VPC 客户端初始化失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+			}
+
+			// 分页请求数据
+			pageSize := 100 // 每页返回的条数（DescribeEipAddresses 最大 100）
+			pageNumber := 1 // 从第一页开始
+			totalCount := 0 // 总条数
+			var records []database.EIPRecord
+			for {
+				request := vpc.CreateDescribeEipAddressesRequest()
+				request.PageSize = requests.NewInteger(pageSize)     // 设置每页最大条数
+				request.PageNumber = requests.NewInteger(pageNumber) // 设置当前页数
+
+				response, err := client.DescribeEipAddresses(request)
+				if err != nil {
+					return fmt.Errorf("EIP API 调用失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+				}
+
+				// 获取总数
+				if totalCount == 0 {
+					totalCount = response.TotalCount
+					logger.Log.Infof("数据查询完成, 区域=%s, 资源=EIP, 账户=%s, 总数=%d 条", regionID, accountName, totalCount)
+				}
+
+				for _, eip := range response.EipAddresses.EipAddress {
+					// 加入共享带宽包的 EIP 带宽可能为空，此时记为 0
+					bandwidth, _ := strconv.ParseInt(eip.Bandwidth, 10, 64)
+
+					records = append(records, database.EIPRecord{
+						AllocationID:       eip.AllocationId,
+						CloudName:          accountName,
+						Name:               eip.Name,
+						IPAddress:          eip.IpAddress,
+						RegionID:           regionID,
+						Bandwidth:          bandwidth,
+						ChargeType:         eip.ChargeType,
+						InternetChargeType: eip.InternetChargeType,
+						InstanceType:       eip.InstanceType,
+						InstanceID:         eip.InstanceId,
+						Status:             eip.Status,
+						AllocationTime:     eip.AllocationTime,
+						Description:        eip.Description,
+					})
+				}
+				// 如果返回的数据条数小于 pageSize，说明已经拉取到最后一页，退出循环
+				if len(response.EipAddresses.EipAddress) < pageSize {
+					break
+				}
+
+				// 请求下一页数据
+				pageNumber++
+			}
+
+			// 保存 EIP 数据
+			if err := database.SaveEIPRecords(accountName, regionID, records); err != nil {
+				return fmt.Errorf("保存 EIP 数据失败 (账户=%s): %w", accountName, err)
+			}
+
+			logger.Log.Infof("数据同步完成, 区域=%s, 资源=EIP, 账户=%s, 同步=%d 条", regionID, accountName, len(records))
+		} else {
+			logger.Log.Warnf("当前阿里账户, 区域=%s, 资源=EIP, 账户=%s, 暂无可用区域。", regionID, accountName)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+	"github.com/WillemCode/AliCloud_Resources/pkg/logger"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ess"
+)
+
+// SyncESSInfo 同步指定账户和区域的弹性伸缩组、伸缩配置及组内实例
+func SyncESSInfo(accountName string, essRegionIds []string, accessKey string, accessSecret string) error {
+	for _, regionID := range essRegionIds {
+		if regionID != "nil" && regionID != "" {
+			logger.Log.Infof("开始同步信息, 区域=%s, 资源=ESS, 账户=%s", regionID, accountName)
+
+			// 初始化 ESS 客户端
+			client, err := ess.NewClientWithAccessKey(regionID, accessKey, accessSecret)
+			if err != nil {
+				return fmt.Errorf("ESS 客户端初始化失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+			}
+
+			// 分页请求数据
+			var records []database.ScalingGroupRecord
+			pageSize := 50  // 每页返回的条数
+			pageNumber := 1 // 从第一页开始
+			totalCount := 0 // 总条数
+			for {
+				request := ess.CreateDescribeScalingGroupsRequest()
+				request.RegionId = regionID
+				request.PageSize = requests.NewInteger(pageSize)
+				request.PageNumber = requests.NewInteger(pageNumber)
+
+				response, err := client.DescribeScalingGroups(request)
+				if err != nil {
+					return fmt.Errorf("ESS API 调用失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+				}
+
+				// 获取总数
+				if totalCount == 0 {
+					totalCount = response.TotalCount
+					logger.Log.Infof("数据查询完成, 区域=%s, 资源=ESS, 账户=%s, 总数=%d 条", regionID, accountName, totalCount)
+				}
+
+				for _, group := range response.ScalingGroups.ScalingGroup {
+					records = append(records, scalingGroupRecord(accountName, group))
+				}
+				// 如果返回的数据条数小于 pageSize，说明已经拉取到最后一页，退出循环
+				if len(response.ScalingGroups.ScalingGroup) < pageSize {
+					break
+				}
+
+				// 请求下一页数据
+				pageNumber++
+			}
+
+			// 保存伸缩组数据
+			if err := database.SaveScalingGroupRecords(accountName, regionID, records); err != nil {
+				return fmt.Errorf("保存 ESS 数据失败 (账户=%s): %w", accountName, err)
+			}
+
+			// 逐个伸缩组同步伸缩配置及组内实例
+			for _, rec := range records {
+				configurations, err := listScalingConfigurations(client, rec.ScalingGroupID)
+				if err != nil {
+					return err
+				}
+				instances, err := listScalingInstances(client, rec.ScalingGroupID)
+				if err != nil {
+					return err
+				}
+				if err := database.SaveScalingGroupDetails(rec.ScalingGroupID, configurations, instances); err != nil {
+					return fmt.Errorf("保存 ESS 伸缩组详情失败 (账户=%s): %w", accountName, err)
+				}
+			}
+
+			logger.Log.Infof("数据同步完成, 区域=%s, 资源=ESS, 账户=%s, 同步=%d 条", regionID, accountName, len(records))
+		} else {
+			logger.Log.Warnf("当前阿里账户, 区域=%s, 资源=ESS, 账户=%s, 暂无可用区域。", regionID, accountName)
+		}
+	}
+	return nil
+}
+
+// scalingGroupRecord 转换伸缩组，CLB 实例和 ALB/NLB 服务器组分别记录
+func scalingGroupRecord(accountName string, group ess.ScalingGroup) database.ScalingGroupRecord {
+	vswitchIDs := group.VSwitchIds.VSwitchId
+	if len(vswitchIDs) == 0 && group.VSwitchId != "" {
+		vswitchIDs = []string{group.VSwitchId}
+	}
+
+	// CLB 实例可能同时出现在 LoadBalancerIds 与 VServerGroups 中，合并时去重
+	lbIDs := group.LoadBalancerIds.LoadBalancerId
+	for _, vgroup := range group.VServerGroups.VServerGroup {
+		lbIDs = append(lbIDs, vgroup.LoadBalancerId)
+	}
+	var serverGroupIDs []string
+	for _, sg := range group.AlbServerGroups.AlbServerGroup {
+		serverGroupIDs = append(serverGroupIDs, sg.AlbServerGroupId)
+	}
+	for _, sg := range group.ServerGroups.ServerGroup {
+		serverGroupIDs = append(serverGroupIDs, sg.ServerGroupId)
+	}
+
+	return database.ScalingGroupRecord{
+		ScalingGroupID:        group.ScalingGroupId,
+		CloudName:             accountName,
+		Name:                  group.ScalingGroupName,
+		RegionID:              group.RegionId,
+		Status:                group.LifecycleState,
+		MinSize:               int64(group.MinSize),
+		MaxSize:               int64(group.MaxSize),
+		DesiredCapacity:       int64(group.DesiredCapacity),
+		TotalCapacity:         int64(group.TotalCapacity),
+		ActiveConfigurationID: group.ActiveScalingConfigurationId,
+		LaunchTemplateID:      group.LaunchTemplateId,
+		VPCID:                 group.VpcId,
+		VSwitchIDs:            strings.Join(vswitchIDs, ","),
+		LoadBalancerIDs:       joinUnique(lbIDs...),
+		ServerGroupIDs:        strings.Join(serverGroupIDs, ","),
+		DBInstanceIDs:         strings.Join(group.DBInstanceIds.DBInstanceId, ","),
+		CreateTime:            group.CreationTime,
+	}
+}
+
+// listScalingConfigurations 分页查询伸缩组的伸缩配置
+func listScalingConfigurations(client *ess.Client, scalingGroupID string) ([]database.ScalingConfigurationRecord, error) {
+	var records []database.ScalingConfigurationRecord
+	pageSize := 50
+	pageNumber := 1
+	for {
+		request := ess.CreateDescribeScalingConfigurationsRequest()
+		request.ScalingGroupId = scalingGroupID
+		request.PageSize = requests.NewInteger(pageSize)
+		request.PageNumber = requests.NewInteger(pageNumber)
+
+		response, err := client.DescribeScalingConfigurations(request)
+		if err != nil {
+			return nil, fmt.Errorf("获取 ESS 伸缩配置失败 (ScalingGroupID=%s): %w", scalingGroupID, err)
+		}
+		for _, conf := range response.ScalingConfigurations.ScalingConfiguration {
+			instanceTypes := conf.InstanceTypes.InstanceType
+			if len(instanceTypes) == 0 && conf.InstanceType != "" {
+				instanceTypes = []string{conf.InstanceType}
+			}
+			records = append(records, database.ScalingConfigurationRecord{
+				ConfigurationID: conf.ScalingConfigurationId,
+				Name:            conf.ScalingConfigurationName,
+				InstanceTypes:   strings.Join(instanceTypes, ","),
+				CPU:             int64(conf.Cpu),
+				Memory:          int64(conf.Memory),
+				ImageID:         conf.ImageId,
+				ImageName:       conf.ImageName,
+				SecurityGroupID: conf.SecurityGroupId,
+				Status:          conf.LifecycleState,
+				CreateTime:      conf.CreationTime,
+			})
+		}
+		if len(response.ScalingConfigurations.ScalingConfiguration) < pageSize {
+			break
+		}
+		pageNumber++
+	}
+	return records, nil
+}
+
+// listScalingInstances 分页查询伸缩组内的 ECS 实例
+func listScalingInstances(client *ess.Client, scalingGroupID string) ([]database.ScalingInstanceRecord, error) {
+	var records []database.ScalingInstanceRecord
+	pageSize := 50
+	pageNumber := 1
+	for {
+		request := ess.CreateDescribeScalingInstancesRequest()
+		request.ScalingGroupId = scalingGroupID
+		request.PageSize = requests.NewInteger(pageSize)
+		request.PageNumber = requests.NewInteger(pageNumber)
+
+		response, err := client.DescribeScalingInstances(request)
+		if err != nil {
+			return nil, fmt.Errorf("获取 ESS 伸缩组实例失败 (ScalingGroupID=%s): %w", scalingGroupID, err)
+		}
+		for _, instance := range response.ScalingInstances.ScalingInstance {
+			records = append(records, database.ScalingInstanceRecord{
+				InstanceID:      instance.InstanceId,
+				ConfigurationID: instance.ScalingConfigurationId,
+				LifecycleState:  instance.LifecycleState,
+				HealthStatus:    instance.HealthStatus,
+				CreationType:    instance.CreationType,
+				CreateTime:      instance.CreationTime,
+			})
+		}
+		if len(response.ScalingInstances.ScalingInstance) < pageSize {
+			break
+		}
+		pageNumber++
+	}
+	return records, nil
+}
@@ -0,0 +1,259 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+	"github.com/WillemCode/AliCloud_Resources/pkg/logger"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/sts"
+)
+
+// FC 2.0 OpenAPI 版本，SDK 未提供 fc 包，通过 CommonRequest 以 ROA 风格调用
+const fcAPIVersion = "2021-04-06"
+
+// fcService ListServices 返回的服务
+type fcService struct {
+	ServiceID        string `json:"serviceId"`
+	ServiceName      string `json:"serviceName"`
+	Description      string `json:"description"`
+	Role             string `json:"role"`
+	InternetAccess   bool   `json:"internetAccess"`
+	CreatedTime      string `json:"createdTime"`
+	LastModifiedTime string `json:"lastModifiedTime"`
+	VPCConfig        struct {
+		VPCID           string   `json:"vpcId"`
+		VSwitchIDs      []string `json:"vSwitchIds"`
+		SecurityGroupID string   `json:"securityGroupId"`
+	} `json:"vpcConfig"`
+	LogConfig struct {
+		Project  string `json:"project"`
+		Logstore string `json:"logstore"`
+	} `json:"logConfig"`
+}
+
+// fcFunction ListFunctions 返回的函数
+type fcFunction struct {
+	FunctionID       string  `json:"functionId"`
+	FunctionName     string  `json:"functionName"`
+	Runtime          string  `json:"runtime"`
+	Handler          string  `json:"handler"`
+	MemorySize       int64   `json:"memorySize"`
+	CPU              float64 `json:"cpu"`
+	Timeout          int64   `json:"timeout"`
+	InstanceType     string  `json:"instanceType"`
+	Description      string  `json:"description"`
+	CreatedTime      string  `json:"createdTime"`
+	LastModifiedTime string  `json:"lastModifiedTime"`
+}
+
+// fcTrigger ListTriggers 返回的触发器
+type fcTrigger struct {
+	TriggerID   string `json:"triggerId"`
+	TriggerName string `json:"triggerName"`
+	TriggerType string `json:"triggerType"`
+	Qualifier   string `json:"qualifier"`
+	SourceARN   string `json:"sourceArn"`
+	CreatedTime string `json:"createdTime"`
+}
+
+// SyncFCInfo 同步指定账户和区域的函数计算服务、函数及触发器
+func SyncFCInfo(accountName string, fcRegionIds []string, accessKey string, accessSecret string) error {
+	// FC 接入地址包含主账号 ID，先通过 STS 获取
+	accountID := ""
+	for _, regionID := range fcRegionIds {
+		if regionID != "nil" && regionID != "" {
+			logger.Log.Infof("开始同步信息, 区域=%s, 资源=FC, 账户=%s", regionID, accountName)
+
+			// 初始化 FC 客户端
+			client, err := sdk.NewClientWithAccessKey(regionID, accessKey, accessSecret)
+			if err != nil {
+				return fmt.Errorf("FC 客户端初始化失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+			}
+			if accountID == "" {
+				accountID, err = callerAccountID(regionID, accessKey, accessSecret)
+				if err != nil {
+					return fmt.Errorf("FC 获取主账号 ID 失败 (账户=%s): %w", accountName, err)
+				}
+			}
+			endpoint := fmt.Sprintf("%s.%s.fc.aliyuncs.com", accountID, regionID)
+
+			// 使用 NextToken 分页请求服务列表
+			var records []database.FCServiceRecord
+			nextToken := ""
+			for {
+				var response struct {
+					Services  []fcService `json:"services"`
+					NextToken string      `json:"nextToken"`
+				}
+				path := "/" + fcAPIVersion + "/services"
+				if err := fcGet(client, endpoint, path, nextToken, &response); err != nil {
+					return fmt.Errorf("FC API 调用失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+				}
+				for _, service := range response.Services {
+					records = append(records, database.FCServiceRecord{
+						ServiceID:       service.ServiceID,
+						CloudName:       accountName,
+						RegionID:        regionID,
+						ServiceName:     service.ServiceName,
+						Description:     service.Description,
+						Role:            service.Role,
+						InternetAccess:  service.InternetAccess,
+						VPCID:           service.VPCConfig.VPCID,
+						VSwitchIDs:      strings.Join(service.VPCConfig.VSwitchIDs, ","),
+						SecurityGroupID: service.VPCConfig.SecurityGroupID,
+						LogProject:      service.LogConfig.Project,
+						LogStore:        service.LogConfig.Logstore,
+						CreateTime:      service.CreatedTime,
+						ModifyTime:      service.LastModifiedTime,
+					})
+				}
+				if response.NextToken == "" {
+					break
+				}
+				nextToken = response.NextToken
+			}
+			logger.Log.Infof("数据查询完成, 区域=%s, 资源=FC, 账户=%s, 总数=%d 条", regionID, accountName, len(records))
+
+			// 保存服务数据
+			if err := database.SaveFCServiceRecords(accountName, regionID, records); err != nil {
+				return fmt.Errorf("保存 FC 数据失败 (账户=%s): %w", accountName, err)
+			}
+
+			// 逐个服务同步函数及触发器
+			for _, rec := range records {
+				functions, triggers, err := listFCFunctions(client, endpoint, rec)
+				if err != nil {
+					return err
+				}
+				if err := database.SaveFCServiceDetails(rec.ServiceID, functions, triggers); err != nil {
+					return fmt.Errorf("保存 FC 函数数据失败 (账户=%s): %w", accountName, err)
+				}
+			}
+
+			logger.Log.Infof("数据同步完成, 区域=%s, 资源=FC, 账户=%s, 同步=%d 条", regionID, accountName, len(records))
+		} else {
+			logger.Log.Warnf("当前阿里账户, 区域=%s, 资源=FC, 账户=%s, 暂无可用区域。", regionID, accountName)
+		}
+	}
+	return nil
+}
+
+// callerAccountID 通过 STS GetCallerIdentity 获取 AccessKey 所属主账号 ID
+func callerAccountID(regionID, accessKey, accessSecret string) (string, error) {
+	client, err := sts.NewClientWithAccessKey(regionID, accessKey, accessSecret)
+	if err != nil {
+		return "", err
+	}
+	request := sts.CreateGetCallerIdentityRequest()
+	request.Scheme = "https"
+	response, err := client.GetCallerIdentity(request)
+	if err != nil {
+		return "", err
+	}
+	return response.AccountId, nil
+}
+
+// fcGet 以 ROA 风格发起 FC GET 请求，并将响应解析到 result
+func fcGet(client *sdk.Client, endpoint, path, nextToken string, result interface{}) error {
+	request := requests.NewCommonRequest()
+	request.Method = "GET"
+	request.Scheme = "https"
+	request.Domain = endpoint
+	request.Version = fcAPIVersion
+	request.PathPattern = path
+	request.QueryParams["limit"] = "100"
+	if nextToken != "" {
+		request.QueryParams["nextToken"] = nextToken
+	}
+
+	response, err := client.ProcessCommonRequest(request)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(response.GetHttpContentBytes(), result); err != nil {
+		return fmt.Errorf("解析 FC 响应失败 (Path=%s): %w", path, err)
+	}
+	return nil
+}
+
+// listFCFunctions 分页查询服务下的函数，并逐个查询函数的触发器
+func listFCFunctions(client *sdk.Client, endpoint string, service database.FCServiceRecord) ([]database.FCFunctionRecord, []database.FCTriggerRecord, error) {
+	var functions []database.FCFunctionRecord
+	var triggers []database.FCTriggerRecord
+	nextToken := ""
+	for {
+		var response struct {
+			Functions []fcFunction `json:"functions"`
+			NextToken string       `json:"nextToken"`
+		}
+		path := "/" + fcAPIVersion + "/services/" + service.ServiceName + "/functions"
+		if err := fcGet(client, endpoint, path, nextToken, &response); err != nil {
+			return nil, nil, fmt.Errorf("获取 FC 函数失败 (Service=%s): %w", service.ServiceName, err)
+		}
+		for _, function := range response.Functions {
+			functions = append(functions, database.FCFunctionRecord{
+				FunctionID:   function.FunctionID,
+				ServiceID:    service.ServiceID,
+				FunctionName: function.FunctionName,
+				Runtime:      function.Runtime,
+				Handler:      function.Handler,
+				MemorySize:   function.MemorySize,
+				CPU:          function.CPU,
+				Timeout:      function.Timeout,
+				InstanceType: function.InstanceType,
+				Description:  function.Description,
+				CreateTime:   function.CreatedTime,
+				ModifyTime:   function.LastModifiedTime,
+			})
+
+			functionTriggers, err := listFCTriggers(client, endpoint, service, function)
+			if err != nil {
+				return nil, nil, err
+			}
+			triggers = append(triggers, functionTriggers...)
+		}
+		if response.NextToken == "" {
+			break
+		}
+		nextToken = response.NextToken
+	}
+	return functions, triggers, nil
+}
+
+// listFCTriggers 分页查询函数的触发器
+func listFCTriggers(client *sdk.Client, endpoint string, service database.FCServiceRecord, function fcFunction) ([]database.FCTriggerRecord, error) {
+	var records []database.FCTriggerRecord
+	nextToken := ""
+	for {
+		var response struct {
+			Triggers  []fcTrigger `json:"triggers"`
+			NextToken string      `json:"nextToken"`
+		}
+		path := "/" + fcAPIVersion + "/services/" + service.ServiceName + "/functions/" + function.FunctionName + "/triggers"
+		if err := fcGet(client, endpoint, path, nextToken, &response); err != nil {
+			return nil, fmt.Errorf("获取 FC 触发器失败 (Function=%s/%s): %w", service.ServiceName, function.FunctionName, err)
+		}
+		for _, trigger := range response.Triggers {
+			records = append(records, database.FCTriggerRecord{
+				TriggerID:   trigger.TriggerID,
+				ServiceID:   service.ServiceID,
+				FunctionID:  function.FunctionID,
+				TriggerName: trigger.TriggerName,
+				TriggerType: trigger.TriggerType,
+				Qualifier:   trigger.Qualifier,
+				SourceARN:   trigger.SourceARN,
+				CreateTime:  trigger.CreatedTime,
+			})
+		}
+		if response.NextToken == "" {
+			break
+		}
+		nextToken = response.NextToken
+	}
+	return records, nil
+}
@@ -0,0 +1,22 @@
+package services
+
+import (
+	"strings"
+)
+
+// joinUnique 以逗号合并多个值，忽略空值及重复值
+// 单个值本身为逗号分隔的列表时（如 Kafka 默认接入点）会先拆分再合并
+func joinUnique(values ...string) string {
+	seen := map[string]bool{}
+	var list []string
+	for _, value := range values {
+		for _, item := range strings.Split(value, ",") {
+			item = strings.TrimSpace(item)
+			if item != "" && !seen[item] {
+				seen[item] = true
+				list = append(list, item)
+			}
+		}
+	}
+	return strings.Join(list, ",")
+}
@@ -0,0 +1,423 @@
+package services
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+	"github.com/WillemCode/AliCloud_Resources/pkg/logger"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/cbn"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+)
+
+// SyncHybridNetworkInfo 同步指定账户和区域的 VPN 网关、用户网关、IPsec 连接、边界路由器及物理专线
+func SyncHybridNetworkInfo(accountName string, vpcRegionIds []string, accessKey string, accessSecret string) error {
+	for _, regionID := range vpcRegionIds {
+		if regionID != "nil" && regionID != "" {
+			logger.Log.Infof("开始同步信息, 区域=%s, 资源=混合云网络, 账户=%s", regionID, accountName)
+
+			// 初始化 VPC 客户端（VPN 网关及高速通道接口同属 VPC 产品）
+			client, err := vpc.NewClientWithAccessKey(regionID, accessKey, accessSecret)
+			if err != nil {
+				return fmt.Errorf("VPC 客户端初始化失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+			}
+
+			if err := syncVPNGateways(client, accountName, regionID); err != nil {
+				return err
+			}
+			if err := syncCustomerGateways(client, accountName, regionID); err != nil {
+				return err
+			}
+			if err := syncVPNConnections(client, accountName, regionID); err != nil {
+				return err
+			}
+			if err := syncVBRs(client, accountName, regionID); err != nil {
+				return err
+			}
+			if err := syncPhysicalConnections(client, accountName, regionID); err != nil {
+				return err
+			}
+		} else {
+			logger.Log.Warnf("当前阿里账户, 区域=%s, 资源=混合云网络, 账户=%s, 暂无可用区域。", regionID, accountName)
+		}
+	}
+	return nil
+}
+
+// syncVPNGateways 分页拉取区域内的 VPN 网关并保存
+func syncVPNGateways(client *vpc.Client, accountName string, regionID string) error {
+	pageSize := 50  // 每页返回的条数（最大 50）
+	pageNumber := 1 // 从第一页开始
+	var records []database.VPNGatewayRecord
+	for {
+		request := vpc.CreateDescribeVpnGatewaysRequest()
+		request.RegionId = regionID
+		request.PageSize = requests.NewInteger(pageSize)
+		request.PageNumber = requests.NewInteger(pageNumber)
+
+		response, err := client.DescribeVpnGateways(request)
+		if err != nil {
+			return fmt.Errorf("VPN 网关 API 调用失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+		}
+
+		for _, gw := range response.VpnGateways.VpnGateway {
+			records = append(records, database.VPNGatewayRecord{
+				VPNGatewayID: gw.VpnGatewayId,
+				CloudName:    accountName,
+				RegionID:     regionID,
+				Name:         gw.Name,
+				VPCID:        gw.VpcId,
+				VSwitchID:    gw.VSwitchId,
+				InternetIP:   gw.InternetIp,
+				Spec:         gw.Spec,
+				Status:       gw.Status,
+				IPsecVPN:     gw.IpsecVpn,
+				SSLVPN:       gw.SslVpn,
+				CreateTime:   formatMillis(gw.CreateTime),
+			})
+		}
+		if len(response.VpnGateways.VpnGateway) < pageSize {
+			break
+		}
+		pageNumber++
+	}
+
+	if err := database.SaveVPNGatewayRecords(accountName, regionID, records); err != nil {
+		return fmt.Errorf("保存 VPN 网关数据失败 (账户=%s): %w", accountName, err)
+	}
+	logger.Log.Infof("数据同步完成, 区域=%s, 资源=VPN网关, 账户=%s, 同步=%d 条", regionID, accountName, len(records))
+	return nil
+}
+
+// syncCustomerGateways 分页拉取区域内的用户网关并保存
+func syncCustomerGateways(client *vpc.Client, accountName string, regionID string) error {
+	pageSize := 50
+	pageNumber := 1
+	var records []database.CustomerGatewayRecord
+	for {
+		request := vpc.CreateDescribeCustomerGatewaysRequest()
+		request.RegionId = regionID
+		request.PageSize = requests.NewInteger(pageSize)
+		request.PageNumber = requests.NewInteger(pageNumber)
+
+		response, err := client.DescribeCustomerGateways(request)
+		if err != nil {
+			return fmt.Errorf("用户网关 API 调用失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+		}
+
+		for _, gw := range response.CustomerGateways.CustomerGateway {
+			records = append(records, database.CustomerGatewayRecord{
+				CustomerGatewayID: gw.CustomerGatewayId,
+				CloudName:         accountName,
+				RegionID:          regionID,
+				Name:              gw.Name,
+				IPAddress:         gw.IpAddress,
+				ASN:               gw.Asn,
+				Description:       gw.Description,
+				CreateTime:        formatMillis(gw.CreateTime),
+			})
+		}
+		if len(response.CustomerGateways.CustomerGateway) < pageSize {
+			break
+		}
+		pageNumber++
+	}
+
+	if err := database.SaveCustomerGatewayRecords(accountName, regionID, records); err != nil {
+		return fmt.Errorf("保存用户网关数据失败 (账户=%s): %w", accountName, err)
+	}
+	logger.Log.Infof("数据同步完成, 区域=%s, 资源=用户网关, 账户=%s, 同步=%d 条", regionID, accountName, len(records))
+	return nil
+}
+
+// syncVPNConnections 分页拉取区域内的 IPsec 连接并保存
+func syncVPNConnections(client *vpc.Client, accountName string, regionID string) error {
+	pageSize := 50
+	pageNumber := 1
+	var records []database.VPNConnectionRecord
+	for {
+		request := vpc.CreateDescribeVpnConnectionsRequest()
+		request.RegionId = regionID
+		request.PageSize = requests.NewInteger(pageSize)
+		request.PageNumber = requests.NewInteger(pageNumber)
+
+		response, err := client.DescribeVpnConnections(request)
+		if err != nil {
+			return fmt.Errorf("IPsec 连接 API 调用失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+		}
+
+		for _, conn := range response.VpnConnections.VpnConnection {
+			records = append(records, database.VPNConnectionRecord{
+				VPNConnectionID:   conn.VpnConnectionId,
+				CloudName:         accountName,
+				RegionID:          regionID,
+				Name:              conn.Name,
+				VPNGatewayID:      conn.VpnGatewayId,
+				CustomerGatewayID: conn.CustomerGatewayId,
+				LocalSubnet:       conn.LocalSubnet,
+				RemoteSubnet:      conn.RemoteSubnet,
+				Status:            conn.Status,
+				CreateTime:        formatMillis(conn.CreateTime),
+			})
+		}
+		if len(response.VpnConnections.VpnConnection) < pageSize {
+			break
+		}
+		pageNumber++
+	}
+
+	if err := database.SaveVPNConnectionRecords(accountName, regionID, records); err != nil {
+		return fmt.Errorf("保存 IPsec 连接数据失败 (账户=%s): %w", accountName, err)
+	}
+	logger.Log.Infof("数据同步完成, 区域=%s, 资源=IPsec连接, 账户=%s, 同步=%d 条", regionID, accountName, len(records))
+	return nil
+}
+
+// syncVBRs 分页拉取区域内的边界路由器并保存
+func syncVBRs(client *vpc.Client, accountName string, regionID string) error {
+	pageSize := 50
+	pageNumber := 1
+	var records []database.VBRRecord
+	for {
+		request := vpc.CreateDescribeVirtualBorderRoutersRequest()
+		request.RegionId = regionID
+		request.PageSize = requests.NewInteger(pageSize)
+		request.PageNumber = requests.NewInteger(pageNumber)
+
+		response, err := client.DescribeVirtualBorderRouters(request)
+		if err != nil {
+			return fmt.Errorf("边界路由器 API 调用失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+		}
+
+		for _, vbr := range response.VirtualBorderRouterSet.VirtualBorderRouterType {
+			records = append(records, database.VBRRecord{
+				VBRID:                vbr.VbrId,
+				CloudName:            accountName,
+				RegionID:             regionID,
+				Name:                 vbr.Name,
+				PhysicalConnectionID: vbr.PhysicalConnectionId,
+				VLANID:               int64(vbr.VlanId),
+				LocalGatewayIP:       vbr.LocalGatewayIp,
+				PeerGatewayIP:        vbr.PeerGatewayIp,
+				PeeringSubnetMask:    vbr.PeeringSubnetMask,
+				Status:               vbr.Status,
+				CreateTime:           vbr.CreationTime,
+			})
+		}
+		if len(response.VirtualBorderRouterSet.VirtualBorderRouterType) < pageSize {
+			break
+		}
+		pageNumber++
+	}
+
+	if err := database.SaveVBRRecords(accountName, regionID, records); err != nil {
+		return fmt.Errorf("保存边界路由器数据失败 (账户=%s): %w", accountName, err)
+	}
+	logger.Log.Infof("数据同步完成, 区域=%s, 资源=边界路由器, 账户=%s, 同步=%d 条", regionID, accountName, len(records))
+	return nil
+}
+
+// syncPhysicalConnections 分页拉取区域内的物理专线并保存
+func syncPhysicalConnections(client *vpc.Client, accountName string, regionID string) error {
+	pageSize := 50
+	pageNumber := 1
+	var records []database.PhysicalConnectionRecord
+	for {
+		request := vpc.CreateDescribePhysicalConnectionsRequest()
+		request.RegionId = regionID
+		request.PageSize = requests.NewInteger(pageSize)
+		request.PageNumber = requests.NewInteger(pageNumber)
+
+		response, err := client.DescribePhysicalConnections(request)
+		if err != nil {
+			return fmt.Errorf("物理专线 API 调用失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+		}
+
+		for _, pc := range response.PhysicalConnectionSet.PhysicalConnectionType {
+			records = append(records, database.PhysicalConnectionRecord{
+				PhysicalConnectionID: pc.PhysicalConnectionId,
+				CloudName:            accountName,
+				RegionID:             regionID,
+				Name:                 pc.Name,
+				AccessPointID:        pc.AccessPointId,
+				LineOperator:         pc.LineOperator,
+				PeerLocation:         pc.PeerLocation,
+				Bandwidth:            pc.Bandwidth,
+				PortType:             pc.PortType,
+				Status:               pc.Status,
+				BusinessStatus:       pc.BusinessStatus,
+				CreateTime:           pc.CreationTime,
+			})
+		}
+		if len(response.PhysicalConnectionSet.PhysicalConnectionType) < pageSize {
+			break
+		}
+		pageNumber++
+	}
+
+	if err := database.SavePhysicalConnectionRecords(accountName, regionID, records); err != nil {
+		return fmt.Errorf("保存物理专线数据失败 (账户=%s): %w", accountName, err)
+	}
+	logger.Log.Infof("数据同步完成, 区域=%s, 资源=物理专线, 账户=%s, 同步=%d 条", regionID, accountName, len(records))
+	return nil
+}
+
+// SyncCENInfo 同步指定账户的云企业网实例、已加载网络实例及带宽包（全局服务，区域仅用于选择接入点）
+func SyncCENInfo(accountName string, cenRegionIds []string, accessKey string, accessSecret string) error {
+	for _, regionID := range cenRegionIds {
+		if regionID != "nil" && regionID != "" {
+			logger.Log.Infof("开始同步信息, 区域=%s, 资源=CEN, 账户=%s", regionID, accountName)
+
+			// 初始化 CEN 客户端
+			client, err := cbn.NewClientWithAccessKey(regionID, accessKey, accessSecret)
+			if err != nil {
+				return fmt.Errorf("CEN 客户端初始化失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+			}
+
+			// 分页请求数据
+			var records []database.CENRecord
+			pageSize := 50  // 每页返回的条数
+			pageNumber := 1 // 从第一页开始
+			totalCount := 0 // 总条数
+			for {
+				request := cbn.CreateDescribeCensRequest()
+				request.PageSize = requests.NewInteger(pageSize)
+				request.PageNumber = requests.NewInteger(pageNumber)
+
+				response, err := client.DescribeCens(request)
+				if err != nil {
+					return fmt.Errorf("CEN API 调用失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+				}
+
+				// 获取总数
+				if totalCount == 0 {
+					totalCount = response.TotalCount
+					logger.Log.Infof("数据查询完成, 区域=%s, 资源=CEN, 账户=%s, 总数=%d 条", regionID, accountName, totalCount)
+				}
+
+				for _, cen := range response.Cens.Cen {
+					records = append(records, database.CENRecord{
+						CENID:           cen.CenId,
+						CloudName:       accountName,
+						Name:            cen.Name,
+						Status:          cen.Status,
+						ProtectionLevel: cen.ProtectionLevel,
+						Description:     cen.Description,
+						CreateTime:      cen.CreationTime,
+					})
+				}
+				// 如果返回的数据条数小于 pageSize，说明已经拉取到最后一页，退出循环
+				if len(response.Cens.Cen) < pageSize {
+					break
+				}
+
+				// 请求下一页数据
+				pageNumber++
+			}
+
+			// 保存实例数据
+			if err := database.SaveCENRecords(accountName, records); err != nil {
+				return fmt.Errorf("保存 CEN 数据失败 (账户=%s): %w", accountName, err)
+			}
+
+			// 逐个实例同步已加载的网络实例
+			for _, rec := range records {
+				children, err := listCENChildInstances(client, rec.CENID)
+				if err != nil {
+					return err
+				}
+				if err := database.SaveCENChildInstances(rec.CENID, children); err != nil {
+					return fmt.Errorf("保存 CEN 网络实例数据失败 (账户=%s): %w", accountName, err)
+				}
+			}
+
+			// 带宽包
+			packages, err := listCENBandwidthPackages(client, accountName)
+			if err != nil {
+				return fmt.Errorf("CEN 带宽包 API 调用失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+			}
+			if err := database.SaveCENBandwidthPackageRecords(accountName, packages); err != nil {
+				return fmt.Errorf("保存 CEN 带宽包数据失败 (账户=%s): %w", accountName, err)
+			}
+
+			logger.Log.Infof("数据同步完成, 区域=%s, 资源=CEN, 账户=%s, 同步=%d 条", regionID, accountName, len(records))
+		} else {
+			logger.Log.Warnf("当前阿里账户, 区域=%s, 资源=CEN, 账户=%s, 暂无可用区域。", regionID, accountName)
+		}
+	}
+	return nil
+}
+
+// listCENChildInstances 分页查询云企业网已加载的网络实例
+func listCENChildInstances(client *cbn.Client, cenID string) ([]database.CENChildInstanceRecord, error) {
+	var records []database.CENChildInstanceRecord
+	pageSize := 50
+	pageNumber := 1
+	for {
+		request := cbn.CreateDescribeCenAttachedChildInstancesRequest()
+		request.CenId = cenID
+		request.PageSize = requests.NewInteger(pageSize)
+		request.PageNumber = requests.NewInteger(pageNumber)
+
+		response, err := client.DescribeCenAttachedChildInstances(request)
+		if err != nil {
+			return nil, fmt.Errorf("获取 CEN 网络实例失败 (CENID=%s): %w", cenID, err)
+		}
+		for _, child := range response.ChildInstances.ChildInstance {
+			records = append(records, database.CENChildInstanceRecord{
+				CENID:                 cenID,
+				ChildInstanceID:       child.ChildInstanceId,
+				ChildInstanceType:     child.ChildInstanceType,
+				ChildInstanceRegionID: child.ChildInstanceRegionId,
+				ChildInstanceOwnerID:  strconv.FormatInt(child.ChildInstanceOwnerId, 10),
+				Status:                child.Status,
+				AttachTime:            child.ChildInstanceAttachTime,
+			})
+		}
+		if len(response.ChildInstances.ChildInstance) < pageSize {
+			break
+		}
+		pageNumber++
+	}
+	return records, nil
+}
+
+// listCENBandwidthPackages 分页查询账户下的云企业网带宽包
+func listCENBandwidthPackages(client *cbn.Client, accountName string) ([]database.CENBandwidthPackageRecord, error) {
+	var records []database.CENBandwidthPackageRecord
+	pageSize := 50
+	pageNumber := 1
+	for {
+		request := cbn.CreateDescribeCenBandwidthPackagesRequest()
+		request.PageSize = requests.NewInteger(pageSize)
+		request.PageNumber = requests.NewInteger(pageNumber)
+
+		response, err := client.DescribeCenBandwidthPackages(request)
+		if err != nil {
+			return nil, err
+		}
+		for _, pkg := range response.CenBandwidthPackages.CenBandwidthPackage {
+			records = append(records, database.CENBandwidthPackageRecord{
+				PackageID:         pkg.CenBandwidthPackageId,
+				CloudName:         accountName,
+				Name:              pkg.Name,
+				CENIDs:            strings.Join(pkg.CenIds.CenId, ","),
+				Bandwidth:         pkg.Bandwidth,
+				GeographicRegionA: pkg.GeographicRegionAId,
+				GeographicRegionB: pkg.GeographicRegionBId,
+				Status:            pkg.Status,
+				BusinessStatus:    pkg.BusinessStatus,
+				ChargeType:        pkg.BandwidthPackageChargeType,
+				ExpiredTime:       pkg.ExpiredTime,
+			})
+		}
+		if len(response.CenBandwidthPackages.CenBandwidthPackage) < pageSize {
+			break
+		}
+		pageNumber++
+	}
+	return records, nil
+}
@@ -0,0 +1,171 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+	"github.com/WillemCode/AliCloud_Resources/pkg/logger"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/cms"
+)
+
+// 云监控指标采集配置：资源类型对应的命名空间、实例ID字段及指标名称
+type metricSpec struct {
+	ResourceType string
+	Namespace    string
+	IDKey        string            // 数据点中标识实例的字段
+	Metrics      map[string]string // 汇总指标 -> 云监控指标名称
+}
+
+var metricSpecs = []metricSpec{
+	// ECS 内存使用率依赖云监控插件，未安装插件的实例没有内存数据
+	{database.ResourceTypeECS, "acs_ecs_dashboard", "instanceId", map[string]string{
+		database.MetricCPU:    "CPUUtilization",
+		database.MetricMemory: "memory_usedutilization",
+	}},
+	{database.ResourceTypeRDS, "acs_rds_dashboard", "instanceId", map[string]string{
+		database.MetricCPU:        "CpuUsage",
+		database.MetricMemory:     "MemoryUsage",
+		database.MetricConnection: "ConnectionUsage",
+	}},
+	// 标准架构指标，集群架构按节点上报
+	{database.ResourceTypeRedis, "acs_kvstore", "instanceId", map[string]string{
+		database.MetricCPU:        "StandardCpuUsage",
+		database.MetricMemory:     "StandardMemoryUsage",
+		database.MetricConnection: "StandardConnectionUsage",
+	}},
+	// PolarDB 按节点上报，汇总为集群内全部节点的平均值与峰值
+	{database.ResourceTypePolarDB, "acs_polardb", "clusterId", map[string]string{
+		database.MetricCPU:        "cluster_cpu_utilization",
+		database.MetricMemory:     "cluster_memory_utilization",
+		database.MetricConnection: "cluster_connection_utilization",
+	}},
+}
+
+// SyncMetricInfo 同步指定账户和区域最近 metricDays 天的 ECS、RDS、Redis、PolarDB 云监控指标汇总
+func SyncMetricInfo(accountName string, metricRegionIds []string, accessKey string, accessSecret string, metricDays int) error {
+	if metricDays < 1 {
+		metricDays = 7
+	}
+	for _, regionID := range metricRegionIds {
+		if regionID != "nil" && regionID != "" {
+			logger.Log.Infof("开始同步信息, 区域=%s, 资源=云监控指标, 账户=%s", regionID, accountName)
+
+			// 初始化云监控客户端
+			client, err := cms.NewClientWithAccessKey(regionID, accessKey, accessSecret)
+			if err != nil {
+				return fmt.Errorf("云监控客户端初始化失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+			}
+
+			endTime := time.Now()
+			startTime := endTime.AddDate(0, 0, -metricDays)
+			collectTime := endTime.Format("2006-01-02 15:04:05")
+
+			var records []database.ResourceMetricRecord
+			for _, spec := range metricSpecs {
+				for metric, metricName := range spec.Metrics {
+					summaries, err := summarizeMetric(client, spec, metricName, startTime, endTime)
+					if err != nil {
+						return fmt.Errorf("云监控 API 调用失败 (账户=%s, 区域=%s, 指标=%s): %w", accountName, regionID, metricName, err)
+					}
+					for instanceID, summary := range summaries {
+						records = append(records, database.ResourceMetricRecord{
+							ResourceType: spec.ResourceType,
+							InstanceID:   instanceID,
+							Metric:       metric,
+							CloudName:    accountName,
+							RegionID:     regionID,
+							Average:      summary.Average,
+							Maximum:      summary.Maximum,
+							Samples:      summary.Samples,
+							WindowDays:   int64(metricDays),
+							CollectTime:  collectTime,
+						})
+					}
+				}
+			}
+
+			if err := database.SaveResourceMetricRecords(accountName, regionID, records); err != nil {
+				return fmt.Errorf("保存云监控指标数据失败 (账户=%s): %w", accountName, err)
+			}
+			logger.Log.Infof("数据同步完成, 区域=%s, 资源=云监控指标, 账户=%s, 同步=%d 条", regionID, accountName, len(records))
+		} else {
+			logger.Log.Warnf("当前阿里账户, 区域=%s, 资源=云监控指标, 账户=%s, 暂无可用区域。", regionID, accountName)
+		}
+	}
+	return nil
+}
+
+// 单个实例在采集窗口内的指标汇总
+type metricSummary struct {
+	Average float64
+	Maximum float64
+	Samples int64
+}
+
+// summarizeMetric 按 NextToken 分页拉取小时粒度数据点，并按实例汇总平均值与峰值
+func summarizeMetric(client *cms.Client, spec metricSpec, metricName string, startTime, endTime time.Time) (map[string]*metricSummary, error) {
+	summaries := map[string]*metricSummary{}
+	nextToken := ""
+	for {
+		request := cms.CreateDescribeMetricListRequest()
+		request.Namespace = spec.Namespace
+		request.MetricName = metricName
+		request.StartTime = strconv.FormatInt(startTime.UnixMilli(), 10)
+		request.EndTime = strconv.FormatInt(endTime.UnixMilli(), 10)
+		request.Period = "3600"
+		request.Length = "1000"
+		request.NextToken = nextToken
+
+		response, err := client.DescribeMetricList(request)
+		if err != nil {
+			return nil, err
+		}
+		if !response.Success {
+			return nil, fmt.Errorf("%s: %s", response.Code, response.Message)
+		}
+
+		// 数据点以 JSON 字符串返回
+		var datapoints []map[string]interface{}
+		if response.Datapoints != "" {
+			if err := json.Unmarshal([]byte(response.Datapoints), &datapoints); err != nil {
+				return nil, fmt.Errorf("解析云监控数据点失败 (指标=%s): %w", metricName, err)
+			}
+		}
+		for _, point := range datapoints {
+			instanceID, _ := point[spec.IDKey].(string)
+			average, ok := point["Average"].(float64)
+			if instanceID == "" || !ok {
+				continue
+			}
+			maximum, ok := point["Maximum"].(float64)
+			if !ok {
+				maximum = average
+			}
+			summary, ok := summaries[instanceID]
+			if !ok {
+				summary = &metricSummary{}
+				summaries[instanceID] = summary
+			}
+			// 累计平均值，最后除以采样点数
+			summary.Average += average
+			if maximum > summary.Maximum {
+				summary.Maximum = maximum
+			}
+			summary.Samples++
+		}
+
+		if response.NextToken == "" {
+			break
+		}
+		nextToken = response.NextToken
+	}
+
+	for _, summary := range summaries {
+		summary.Average /= float64(summary.Samples)
+	}
+	return summaries, nil
+}
@@ -0,0 +1,159 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+	"github.com/WillemCode/AliCloud_Resources/pkg/logger"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/dds"
+)
+
+// MongoDB 实例类型，DescribeDBInstances 默认只返回副本集，需要按类型分别查询
+var mongoDBInstanceTypes = []string{"replicate", "sharding"}
+
+// SyncMongoDBInfo 同步指定账户和区域的 MongoDB 实例及连接地址
+func SyncMongoDBInfo(accountName string, mongoRegionIds []string, accessKey string, accessSecret string) error {
+	for _, regionID := range mongoRegionIds {
+		if regionID != "nil" && regionID != "" {
+			logger.Log.Infof("开始同步信息, 区域=%s, 资源=MongoDB, 账户=%s", regionID, accountName)
+
+			// 初始化 MongoDB 客户端
+			client, err := dds.NewClientWithAccessKey(regionID, accessKey, accessSecret)
+			if err != nil {
+				return fmt.Errorf("MongoDB 客户端初始化失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+			}
+
+			var records []database.MongoDBRecord
+			for _, instanceType := range mongoDBInstanceTypes {
+				// 分页请求数据
+				pageSize := 30  // 每页返回的条数
+				pageNumber := 1 // 从第一页开始
+				totalCount := 0 // 总条数
+				for {
+					request := dds.CreateDescribeDBInstancesRequest()
+					request.DBInstanceType = instanceType
+					request.PageSize = requests.NewInteger(pageSize)     // 设置每页最大条数
+					request.PageNumber = requests.NewInteger(pageNumber) // 设置当前页数
+
+					response, err := client.DescribeDBInstances(request)
+					if err != nil {
+						return fmt.Errorf("MongoDB API 调用失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+					}
+
+					// 获取总数
+					if totalCount == 0 {
+						totalCount = response.TotalCount
+						logger.Log.Infof("数据查询完成, 区域=%s, 资源=MongoDB(%s), 账户=%s, 总数=%d 条", regionID, instanceType, accountName, totalCount)
+					}
+
+					for _, instance := range response.DBInstances.DBInstance {
+						endpoints, err := describeMongoDBEndpoints(client, instance.DBInstanceId, instance.DBInstanceType)
+						if err != nil {
+							return err
+						}
+						if err := database.SaveMongoDBEndpoints(instance.DBInstanceId, endpoints); err != nil {
+							return fmt.Errorf("保存 MongoDB 连接地址失败 (账户=%s): %w", accountName, err)
+						}
+						var connectionList []string
+						for _, ep := range endpoints {
+							connectionList = append(connectionList, ep.ConnectionString)
+						}
+
+						records = append(records, database.MongoDBRecord{
+							InstanceID:       instance.DBInstanceId,
+							CloudName:        accountName,
+							Description:      instance.DBInstanceDescription,
+							InstanceType:     instance.DBInstanceType,
+							EngineVersion:    instance.EngineVersion,
+							InstanceClass:    instance.DBInstanceClass,
+							StorageSize:      int64(instance.DBInstanceStorage),
+							StorageType:      instance.StorageType,
+							Status:           instance.DBInstanceStatus,
+							RegionID:         instance.RegionId,
+							ZoneID:           instance.ZoneId,
+							VPCID:            instance.VPCId,
+							VSwitchID:        instance.VSwitchId,
+							ConnectionString: strings.Join(connectionList, ","),
+							CreateTime:       instance.CreationTime,
+						})
+					}
+					// 如果返回的数据条数小于 pageSize，说明已经拉取到最后一页，退出循环
+					if len(response.DBInstances.DBInstance) < pageSize {
+						break
+					}
+
+					// 请求下一页数据
+					pageNumber++
+				}
+			}
+
+			// 保存 MongoDB 数据
+			if err := database.SaveMongoDBRecords(accountName, regionID, records); err != nil {
+				return fmt.Errorf("保存 MongoDB 数据失败 (账户=%s): %w", accountName, err)
+			}
+
+			logger.Log.Infof("数据同步完成, 区域=%s, 资源=MongoDB, 账户=%s, 同步=%d 条", regionID, accountName, len(records))
+		} else {
+			logger.Log.Warnf("当前阿里账户, 区域=%s, 资源=MongoDB, 账户=%s, 暂无可用区域。", regionID, accountName)
+		}
+	}
+	return nil
+}
+
+// describeMongoDBEndpoints 查询 MongoDB 实例的连接地址：副本集查询各角色地址，分片集群查询节点地址
+func describeMongoDBEndpoints(client *dds.Client, instanceID, instanceType string) ([]database.MongoDBEndpointRecord, error) {
+	var endpoints []database.MongoDBEndpointRecord
+
+	if instanceType == "sharding" {
+		request := dds.CreateDescribeShardingNetworkAddressRequest()
+		request.DBInstanceId = instanceID
+		response, err := client.DescribeShardingNetworkAddress(request)
+		if err != nil {
+			return nil, fmt.Errorf("获取 MongoDB 分片集群连接地址失败 (InstanceID=%s): %w", instanceID, err)
+		}
+		for _, addr := range response.NetworkAddresses.NetworkAddress {
+			// 新旧版本接口交换机字段大小写不同
+			vswitchID := addr.VSwitchId
+			if vswitchID == "" {
+				vswitchID = addr.VswitchId
+			}
+			endpoints = append(endpoints, database.MongoDBEndpointRecord{
+				NodeID:           addr.NodeId,
+				NodeType:         addr.NodeType,
+				Role:             addr.Role,
+				ConnectionString: addr.NetworkAddress,
+				Port:             addr.Port,
+				IPAddress:        addr.IPAddress,
+				NetworkType:      addr.NetworkType,
+				VPCID:            addr.VPCId,
+				VSwitchID:        vswitchID,
+				ExpiredTime:      addr.ExpiredTime,
+			})
+		}
+		return endpoints, nil
+	}
+
+	request := dds.CreateDescribeReplicaSetRoleRequest()
+	request.DBInstanceId = instanceID
+	response, err := client.DescribeReplicaSetRole(request)
+	if err != nil {
+		return nil, fmt.Errorf("获取 MongoDB 副本集连接地址失败 (InstanceID=%s): %w", instanceID, err)
+	}
+	for _, rs := range response.ReplicaSets.ReplicaSet {
+		endpoints = append(endpoints, database.MongoDBEndpointRecord{
+			NodeID:           rs.RoleId,
+			NodeType:         "replicate",
+			Role:             rs.ReplicaSetRole,
+			ConnectionString: rs.ConnectionDomain,
+			Port:             rs.ConnectionPort,
+			NetworkType:      rs.NetworkType,
+			VPCID:            rs.VPCId,
+			VSwitchID:        rs.VSwitchId,
+			ExpiredTime:      rs.ExpiredTime,
+		})
+	}
+	return endpoints, nil
+}
@@ -0,0 +1,342 @@
+package services
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+	"github.com/WillemCode/AliCloud_Resources/pkg/logger"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/alikafka"
+	amqp "github.com/aliyun/alibaba-cloud-sdk-go/services/amqp-open"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ons"
+)
+
+// Kafka 实例服务状态
+var kafkaServiceStatus = map[int]string{
+	0:  "待部署",
+	1:  "部署中",
+	5:  "服务中",
+	15: "已过期",
+}
+
+// RocketMQ 实例状态与类型
+var (
+	rocketMQInstanceStatus = map[int]string{
+		0: "铂金版实例部署中",
+		2: "后付费实例已欠费",
+		5: "服务中",
+		7: "升级中",
+	}
+	rocketMQInstanceType = map[int]string{
+		1: "标准版",
+		2: "铂金版",
+	}
+	rocketMQMessageType = map[int]string{
+		0: "普通消息",
+		1: "分区顺序消息",
+		2: "全局顺序消息",
+		4: "事务消息",
+		5: "定时/延时消息",
+	}
+)
+
+// mqStatus 将数值状态转换为可读文本，未知状态保留原始数值
+func mqStatus(names map[int]string, code int) string {
+	if name, ok := names[code]; ok {
+		return name
+	}
+	return strconv.Itoa(code)
+}
+
+// formatMillis 将毫秒时间戳转换为时间字符串，0 表示无时间
+func formatMillis(ms int64) string {
+	if ms <= 0 {
+		return ""
+	}
+	return time.UnixMilli(ms).Format("2006-01-02 15:04:05")
+}
+
+// SyncKafkaInfo 同步指定账户和区域的 Kafka 实例、Topic 及 Group
+func SyncKafkaInfo(accountName string, mqRegionIds []string, accessKey string, accessSecret string) error {
+	for _, regionID := range mqRegionIds {
+		if regionID != "nil" && regionID != "" {
+			logger.Log.Infof("开始同步信息, 区域=%s, 资源=Kafka, 账户=%s", regionID, accountName)
+
+			// 初始化 Kafka 客户端
+			client, err := alikafka.NewClientWithAccessKey(regionID, accessKey, accessSecret)
+			if err != nil {
+				return fmt.Errorf("Kafka 客户端初始化失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+			}
+
+			// 实例列表接口不分页，一次返回区域内全部实例
+			request := alikafka.CreateGetInstanceListRequest()
+			request.RegionId = regionID
+			response, err := client.GetInstanceList(request)
+			if err != nil {
+				return fmt.Errorf("Kafka API 调用失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+			}
+			logger.Log.Infof("数据查询完成, 区域=%s, 资源=Kafka, 账户=%s, 总数=%d 条", regionID, accountName, len(response.InstanceList.InstanceVO))
+
+			var records []database.MQInstanceRecord
+			for _, instance := range response.InstanceList.InstanceVO {
+				resources, err := listKafkaResources(client, instance.InstanceId)
+				if err != nil {
+					return err
+				}
+				if err := database.SaveMQResources(instance.InstanceId, resources); err != nil {
+					return fmt.Errorf("保存 Kafka Topic/Group 失败 (账户=%s): %w", accountName, err)
+				}
+
+				records = append(records, database.MQInstanceRecord{
+					InstanceID:   instance.InstanceId,
+					MQType:       database.MQTypeKafka,
+					CloudName:    accountName,
+					InstanceName: instance.Name,
+					Status:       mqStatus(kafkaServiceStatus, instance.ServiceStatus),
+					Spec:         instance.SpecType,
+					RegionID:     regionID,
+					ZoneID:       instance.ZoneId,
+					VPCID:        instance.VpcId,
+					VSwitchID:    instance.VSwitchId,
+					Endpoints: joinUnique(instance.DomainEndpoint, instance.SslDomainEndpoint, instance.SaslDomainEndpoint,
+						instance.EndPoint, instance.SslEndPoint),
+					CreateTime: formatMillis(instance.CreateTime),
+				})
+			}
+
+			// 保存 Kafka 数据
+			if err := database.SaveMQInstanceRecords(accountName, regionID, database.MQTypeKafka, records); err != nil {
+				return fmt.Errorf("保存 Kafka 数据失败 (账户=%s): %w", accountName, err)
+			}
+
+			logger.Log.Infof("数据同步完成, 区域=%s, 资源=Kafka, 账户=%s, 同步=%d 条", regionID, accountName, len(records))
+		} else {
+			logger.Log.Warnf("当前阿里账户, 区域=%s, 资源=Kafka, 账户=%s, 暂无可用区域。", regionID, accountName)
+		}
+	}
+	return nil
+}
+
+// listKafkaResources 分页查询 Kafka 实例的 Topic 及 Consumer Group
+func listKafkaResources(client *alikafka.Client, instanceID string) ([]database.MQResourceRecord, error) {
+	var resources []database.MQResourceRecord
+
+	pageSize := 100
+	pageNumber := 1
+	for {
+		request := alikafka.CreateGetTopicListRequest()
+		request.InstanceId = instanceID
+		request.PageSize = strconv.Itoa(pageSize)
+		request.CurrentPage = strconv.Itoa(pageNumber)
+
+		response, err := client.GetTopicList(request)
+		if err != nil {
+			return nil, fmt.Errorf("获取 Kafka Topic 失败 (InstanceID=%s): %w", instanceID, err)
+		}
+		for _, topic := range response.TopicList.TopicVO {
+			resources = append(resources, database.MQResourceRecord{
+				InstanceID:   instanceID,
+				ResourceType: "topic",
+				Name:         topic.Topic,
+				Detail:       fmt.Sprintf("分区数=%d", topic.PartitionNum),
+				Remark:       topic.Remark,
+			})
+		}
+		if len(response.TopicList.TopicVO) < pageSize {
+			break
+		}
+		pageNumber++
+	}
+
+	pageNumber = 1
+	for {
+		request := alikafka.CreateGetConsumerListRequest()
+		request.InstanceId = instanceID
+		request.PageSize = requests.NewInteger(pageSize)
+		request.CurrentPage = requests.NewInteger(pageNumber)
+
+		response, err := client.GetConsumerList(request)
+		if err != nil {
+			return nil, fmt.Errorf("获取 Kafka Group 失败 (InstanceID=%s): %w", instanceID, err)
+		}
+		for _, consumer := range response.ConsumerList.ConsumerVO {
+			resources = append(resources, database.MQResourceRecord{
+				InstanceID:   instanceID,
+				ResourceType: "group",
+				Name:         consumer.ConsumerId,
+				Remark:       consumer.Remark,
+			})
+		}
+		if len(response.ConsumerList.ConsumerVO) < pageSize {
+			break
+		}
+		pageNumber++
+	}
+	return resources, nil
+}
+
+// SyncRocketMQInfo 同步指定账户和区域的 RocketMQ（ons）实例、Topic 及 Group
+func SyncRocketMQInfo(accountName string, mqRegionIds []string, accessKey string, accessSecret string) error {
+	for _, regionID := range mqRegionIds {
+		if regionID != "nil" && regionID != "" {
+			logger.Log.Infof("开始同步信息, 区域=%s, 资源=RocketMQ, 账户=%s", regionID, accountName)
+
+			// 初始化 RocketMQ 客户端
+			client, err := ons.NewClientWithAccessKey(regionID, accessKey, accessSecret)
+			if err != nil {
+				return fmt.Errorf("RocketMQ 客户端初始化失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+			}
+
+			// 实例列表接口不分页，一次返回区域内全部实例
+			request := ons.CreateOnsInstanceInServiceListRequest()
+			response, err := client.OnsInstanceInServiceList(request)
+			if err != nil {
+				return fmt.Errorf("RocketMQ API 调用失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+			}
+			logger.Log.Infof("数据查询完成, 区域=%s, 资源=RocketMQ, 账户=%s, 总数=%d 条", regionID, accountName, len(response.Data.InstanceVO))
+
+			var records []database.MQInstanceRecord
+			for _, instance := range response.Data.InstanceVO {
+				// 接入点仅在实例详情中返回
+				infoRequest := ons.CreateOnsInstanceBaseInfoRequest()
+				infoRequest.InstanceId = instance.InstanceId
+				info, err := client.OnsInstanceBaseInfo(infoRequest)
+				if err != nil {
+					return fmt.Errorf("获取 RocketMQ 实例详情失败 (InstanceID=%s): %w", instance.InstanceId, err)
+				}
+				ep := info.InstanceBaseInfo.Endpoints
+
+				resources, err := listRocketMQResources(client, instance.InstanceId)
+				if err != nil {
+					return err
+				}
+				if err := database.SaveMQResources(instance.InstanceId, resources); err != nil {
+					return fmt.Errorf("保存 RocketMQ Topic/Group 失败 (账户=%s): %w", accountName, err)
+				}
+
+				records = append(records, database.MQInstanceRecord{
+					InstanceID:   instance.InstanceId,
+					MQType:       database.MQTypeRocketMQ,
+					CloudName:    accountName,
+					InstanceName: instance.InstanceName,
+					Status:       mqStatus(rocketMQInstanceStatus, instance.InstanceStatus),
+					Spec:         mqStatus(rocketMQInstanceType, instance.InstanceType),
+					RegionID:     regionID,
+					Endpoints: joinUnique(ep.TcpEndpoint, ep.TcpInternetEndpoint, ep.HttpInternalEndpoint,
+						ep.HttpInternetEndpoint, ep.HttpInternetSecureEndpoint),
+					CreateTime: formatMillis(instance.CreateTime),
+				})
+			}
+
+			// 保存 RocketMQ 数据
+			if err := database.SaveMQInstanceRecords(accountName, regionID, database.MQTypeRocketMQ, records); err != nil {
+				return fmt.Errorf("保存 RocketMQ 数据失败 (账户=%s): %w", accountName, err)
+			}
+
+			logger.Log.Infof("数据同步完成, 区域=%s, 资源=RocketMQ, 账户=%s, 同步=%d 条", regionID, accountName, len(records))
+		} else {
+			logger.Log.Warnf("当前阿里账户, 区域=%s, 资源=RocketMQ, 账户=%s, 暂无可用区域。", regionID, accountName)
+		}
+	}
+	return nil
+}
+
+// listRocketMQResources 查询 RocketMQ 实例的 Topic 及 Group
+func listRocketMQResources(client *ons.Client, instanceID string) ([]database.MQResourceRecord, error) {
+	var resources []database.MQResourceRecord
+
+	topicRequest := ons.CreateOnsTopicListRequest()
+	topicRequest.InstanceId = instanceID
+	topicResponse, err := client.OnsTopicList(topicRequest)
+	if err != nil {
+		return nil, fmt.Errorf("获取 RocketMQ Topic 失败 (InstanceID=%s): %w", instanceID, err)
+	}
+	for _, topic := range topicResponse.Data.PublishInfoDo {
+		resources = append(resources, database.MQResourceRecord{
+			InstanceID:   instanceID,
+			ResourceType: "topic",
+			Name:         topic.Topic,
+			Detail:       mqStatus(rocketMQMessageType, topic.MessageType),
+			Remark:       topic.Remark,
+		})
+	}
+
+	groupRequest := ons.CreateOnsGroupListRequest()
+	groupRequest.InstanceId = instanceID
+	groupResponse, err := client.OnsGroupList(groupRequest)
+	if err != nil {
+		return nil, fmt.Errorf("获取 RocketMQ Group 失败 (InstanceID=%s): %w", instanceID, err)
+	}
+	for _, group := range groupResponse.Data.SubscribeInfoDo {
+		resources = append(resources, database.MQResourceRecord{
+			InstanceID:   instanceID,
+			ResourceType: "group",
+			Name:         group.GroupId,
+			Detail:       group.GroupType,
+			Remark:       group.Remark,
+		})
+	}
+	return resources, nil
+}
+
+// SyncAMQPInfo 同步指定账户和区域的 RabbitMQ（AMQP）实例
+func SyncAMQPInfo(accountName string, mqRegionIds []string, accessKey string, accessSecret string) error {
+	for _, regionID := range mqRegionIds {
+		if regionID != "nil" && regionID != "" {
+			logger.Log.Infof("开始同步信息, 区域=%s, 资源=RabbitMQ, 账户=%s", regionID, accountName)
+
+			// 初始化 RabbitMQ 客户端
+			client, err := amqp.NewClientWithAccessKey(regionID, accessKey, accessSecret)
+			if err != nil {
+				return fmt.Errorf("RabbitMQ 客户端初始化失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+			}
+
+			// NextToken 分页请求数据
+			var records []database.MQInstanceRecord
+			nextToken := ""
+			for {
+				request := amqp.CreateListInstancesRequest()
+				request.MaxResults = requests.NewInteger(100)
+				request.NextToken = nextToken
+
+				response, err := client.ListInstances(request)
+				if err != nil {
+					return fmt.Errorf("RabbitMQ API 调用失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+				}
+
+				for _, instance := range response.Data.Instances {
+					records = append(records, database.MQInstanceRecord{
+						InstanceID:   instance.InstanceId,
+						MQType:       database.MQTypeAMQP,
+						CloudName:    accountName,
+						InstanceName: instance.InstanceName,
+						Status:       instance.Status,
+						Spec:         instance.InstanceType,
+						RegionID:     regionID,
+						Endpoints:    joinUnique(instance.PrivateEndpoint, instance.PublicEndpoint, instance.ClassicEndpoint),
+						CreateTime:   formatMillis(instance.OrderCreateTime),
+					})
+				}
+
+				if response.Data.NextToken == "" {
+					break
+				}
+				nextToken = response.Data.NextToken
+			}
+			logger.Log.Infof("数据查询完成, 区域=%s, 资源=RabbitMQ, 账户=%s, 总数=%d 条", regionID, accountName, len(records))
+
+			// 保存 RabbitMQ 数据
+			if err := database.SaveMQInstanceRecords(accountName, regionID, database.MQTypeAMQP, records); err != nil {
+				return fmt.Errorf("保存 RabbitMQ 数据失败 (账户=%s): %w", accountName, err)
+			}
+
+			logger.Log.Infof("数据同步完成, 区域=%s, 资源=RabbitMQ, 账户=%s, 同步=%d 条", regionID, accountName, len(records))
+		} else {
+			logger.Log.Warnf("当前阿里账户, 区域=%s, 资源=RabbitMQ, 账户=%s, 暂无可用区域。", regionID, accountName)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,222 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+	"github.com/WillemCode/AliCloud_Resources/pkg/logger"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/nlb"
+)
+
+// SyncNLBInfo 同步指定账户和区域的 NLB 实例、监听及服务器组
+func SyncNLBInfo(accountName string, slbRegionIds []string, accessKey string, accessSecret string) error {
+	for _, regionID := range slbRegionIds {
+		if regionID != "nil" && regionID != "" {
+			logger.Log.Infof("开始同步信息, 区域=%s, 资源=NLB, 账户=%s", regionID, accountName)
+
+			// 初始化 NLB 客户端
+			client, err := nlb.NewClientWithAccessKey(regionID, accessKey, accessSecret)
+			if err != nil {
+				return fmt.Errorf("NLB 客户端初始化失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+			}
+
+			// NextToken 分页请求数据
+			var records []database.LoadBalancerRecord
+			nextToken := ""
+			totalCount := 0
+			for {
+				request := nlb.CreateListLoadBalancersRequest()
+				request.MaxResults = requests.NewInteger(100)
+				request.NextToken = nextToken
+
+				response, err := client.ListLoadBalancers(request)
+				if err != nil {
+					return fmt.Errorf("NLB API 调用失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+				}
+
+				// 获取总数
+				if totalCount == 0 {
+					totalCount = response.TotalCount
+					logger.Log.Infof("数据查询完成, 区域=%s, 资源=NLB, 账户=%s, 总数=%d 条", regionID, accountName, totalCount)
+				}
+
+				for _, lb := range response.LoadBalancers {
+					// NLB 列表接口直接返回可用区映射
+					var zones, vswitches, addresses []string
+					for _, zm := range lb.ZoneMappings {
+						zones = append(zones, zm.ZoneId)
+						vswitches = append(vswitches, zm.VSwitchId)
+						for _, addr := range zm.LoadBalancerAddresses {
+							for _, ip := range []string{addr.PublicIPv4Address, addr.PrivateIPv4Address, addr.Ipv6Address} {
+								if ip != "" {
+									addresses = append(addresses, ip)
+								}
+							}
+						}
+					}
+					records = append(records, database.LoadBalancerRecord{
+						InstanceID:       lb.LoadBalancerId,
+						LBType:           database.LBTypeNLB,
+						CloudName:        accountName,
+						LoadBalancerName: lb.LoadBalancerName,
+						DNSName:          lb.DNSName,
+						AddressType:      lb.AddressType,
+						Addresses:        strings.Join(addresses, ","),
+						Zones:            strings.Join(zones, ","),
+						VPCID:            lb.VpcId,
+						VSwitchID:        strings.Join(vswitches, ","),
+						RegionID:         regionID,
+						Status:           lb.LoadBalancerStatus,
+						CreateTime:       lb.CreateTime,
+					})
+				}
+
+				if response.NextToken == "" {
+					break
+				}
+				nextToken = response.NextToken
+			}
+
+			// 保存 NLB 数据
+			if err := database.SaveLoadBalancerRecords(accountName, regionID, database.LBTypeNLB, records); err != nil {
+				return fmt.Errorf("保存 NLB 数据失败 (账户=%s): %w", accountName, err)
+			}
+
+			// 逐个实例同步监听及服务器组
+			for _, rec := range records {
+				if err := syncNLBListeners(client, rec.InstanceID); err != nil {
+					return fmt.Errorf("同步 NLB 监听失败 (账户=%s): %w", accountName, err)
+				}
+			}
+
+			logger.Log.Infof("数据同步完成, 区域=%s, 资源=NLB, 账户=%s, 同步=%d 条", regionID, accountName, len(records))
+		} else {
+			logger.Log.Warnf("当前阿里账户, 区域=%s, 资源=NLB, 账户=%s, 暂无可用区域。", regionID, accountName)
+		}
+	}
+	return nil
+}
+
+// syncNLBListeners 同步单个 NLB 实例的监听，以及监听引用的服务器组
+func syncNLBListeners(client *nlb.Client, lbID string) error {
+	groupIDs := map[string]bool{}
+
+	// 1) 监听列表
+	var listeners []database.LBListenerRecord
+	nextToken := ""
+	for {
+		request := nlb.CreateListListenersRequest()
+		request.LoadBalancerIds = &[]string{lbID}
+		request.MaxResults = requests.NewInteger(100)
+		request.NextToken = nextToken
+
+		response, err := client.ListListeners(request)
+		if err != nil {
+			return fmt.Errorf("获取 NLB 监听失败 (LoadBalancerID=%s): %w", lbID, err)
+		}
+		for _, l := range response.Listeners {
+			if l.ServerGroupId != "" {
+				groupIDs[l.ServerGroupId] = true
+			}
+			listeners = append(listeners, database.LBListenerRecord{
+				ListenerID:    l.ListenerId,
+				LBType:        database.LBTypeNLB,
+				LBID:          lbID,
+				ListenerPort:  int64(l.ListenerPort),
+				Protocol:      l.ListenerProtocol,
+				ServerGroupID: l.ServerGroupId,
+				Status:        l.ListenerStatus,
+				CertificateID: strings.Join(l.CertificateIds, ","),
+				Description:   l.ListenerDescription,
+			})
+		}
+		if response.NextToken == "" {
+			break
+		}
+		nextToken = response.NextToken
+	}
+	if err := database.SaveLBListeners(lbID, listeners); err != nil {
+		return err
+	}
+
+	// 2) 服务器组及后端服务器
+	if err := database.DeleteLBServerGroups(lbID); err != nil {
+		return err
+	}
+	if len(groupIDs) == 0 {
+		return nil
+	}
+	var ids []string
+	for id := range groupIDs {
+		ids = append(ids, id)
+	}
+
+	nextToken = ""
+	for {
+		request := nlb.CreateListServerGroupsRequest()
+		request.ServerGroupIds = &ids
+		request.MaxResults = requests.NewInteger(100)
+		request.NextToken = nextToken
+
+		response, err := client.ListServerGroups(request)
+		if err != nil {
+			return fmt.Errorf("获取 NLB 服务器组失败 (LoadBalancerID=%s): %w", lbID, err)
+		}
+		for _, group := range response.ServerGroups {
+			servers, err := listNLBServerGroupServers(client, group.ServerGroupId)
+			if err != nil {
+				return err
+			}
+			groupRec := database.LBServerGroupRecord{
+				ServerGroupID:   group.ServerGroupId,
+				LBType:          database.LBTypeNLB,
+				LBID:            lbID,
+				ServerGroupName: group.ServerGroupName,
+				Protocol:        group.Protocol,
+			}
+			if err := database.SaveLBServerGroup(groupRec, servers); err != nil {
+				return err
+			}
+		}
+		if response.NextToken == "" {
+			break
+		}
+		nextToken = response.NextToken
+	}
+	return nil
+}
+
+// listNLBServerGroupServers 查询 NLB 服务器组内的后端服务器
+func listNLBServerGroupServers(client *nlb.Client, serverGroupID string) ([]database.LBBackendServerRecord, error) {
+	var servers []database.LBBackendServerRecord
+	nextToken := ""
+	for {
+		request := nlb.CreateListServerGroupServersRequest()
+		request.ServerGroupId = serverGroupID
+		request.MaxResults = requests.NewInteger(100)
+		request.NextToken = nextToken
+
+		response, err := client.ListServerGroupServers(request)
+		if err != nil {
+			return nil, fmt.Errorf("获取 NLB 后端服务器失败 (ServerGroupID=%s): %w", serverGroupID, err)
+		}
+		for _, server := range response.Servers {
+			servers = append(servers, database.LBBackendServerRecord{
+				ServerID:    server.ServerId,
+				ServerType:  server.ServerType,
+				ServerIP:    server.ServerIp,
+				Port:        int64(server.Port),
+				Weight:      int64(server.Weight),
+				Description: server.Description,
+			})
+		}
+		if response.NextToken == "" {
+			break
+		}
+		nextToken = response.NextToken
+	}
+	return servers, nil
+}
@@ -0,0 +1,158 @@
+package services
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+	"github.com/WillemCode/AliCloud_Resources/pkg/logger"
+
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+)
+
+// 阻止公共访问配置（SDK 未封装该接口）
+type ossPublicAccessBlock struct {
+	BlockPublicAccess bool `xml:"BlockPublicAccess"`
+}
+
+// Bucket Policy 公共访问状态（SDK 未封装该接口）
+type ossPolicyStatus struct {
+	IsPublic bool `xml:"IsPublic"`
+}
+
+// SyncOSSInfo 同步指定账户和区域的 OSS Bucket 信息
+func SyncOSSInfo(accountName string, ossRegionIds []string, accessKey string, accessSecret string) error {
+	// ListBuckets 返回账户下全部区域的 Bucket，只在第一个区域调用一次，再按区域分组
+	var bucketsByRegion map[string][]oss.BucketProperties
+	for _, regionID := range ossRegionIds {
+		if regionID != "nil" && regionID != "" {
+			logger.Log.Infof("开始同步信息, 区域=%s, 资源=OSS, 账户=%s", regionID, accountName)
+
+			// 初始化 OSS 客户端，使用 V4 签名以便调用 SDK 未封装的子资源接口
+			endpoint := fmt.Sprintf("https://oss-%s.aliyuncs.com", regionID)
+			client, err := oss.New(endpoint, accessKey, accessSecret, oss.Region(regionID), oss.AuthVersion(oss.AuthV4))
+			if err != nil {
+				return fmt.Errorf("OSS 客户端初始化失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+			}
+
+			if bucketsByRegion == nil {
+				if bucketsByRegion, err = listOSSBuckets(client, accountName, regionID); err != nil {
+					return err
+				}
+			}
+			buckets := bucketsByRegion[regionID]
+			logger.Log.Infof("数据查询完成, 区域=%s, 资源=OSS, 账户=%s, 总数=%d 条", regionID, accountName, len(buckets))
+
+			var records []database.OSSBucketRecord
+			for _, bucket := range buckets {
+				rec, err := describeOSSBucket(client, bucket)
+				if err != nil {
+					return err
+				}
+				rec.CloudName = accountName
+				rec.RegionID = regionID
+				records = append(records, rec)
+			}
+
+			// 保存 OSS 数据
+			if err := database.SaveOSSBucketRecords(accountName, regionID, records); err != nil {
+				return fmt.Errorf("保存 OSS 数据失败 (账户=%s): %w", accountName, err)
+			}
+
+			logger.Log.Infof("数据同步完成, 区域=%s, 资源=OSS, 账户=%s, 同步=%d 条", regionID, accountName, len(records))
+		} else {
+			logger.Log.Warnf("当前阿里账户, 区域=%s, 资源=OSS, 账户=%s, 暂无可用区域。", regionID, accountName)
+		}
+	}
+	return nil
+}
+
+// listOSSBuckets 按 Marker 分页列出账户下的全部 Bucket，按所在区域分组
+func listOSSBuckets(client *oss.Client, accountName string, regionID string) (map[string][]oss.BucketProperties, error) {
+	buckets := make(map[string][]oss.BucketProperties)
+	marker := ""
+	for {
+		response, err := client.ListBuckets(oss.Marker(marker), oss.MaxKeys(1000))
+		if err != nil {
+			return nil, fmt.Errorf("OSS API 调用失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+		}
+		for _, bucket := range response.Buckets {
+			// 部分 Bucket 只返回 Location（oss-<区域>）
+			region := bucket.Region
+			if region == "" {
+				region = strings.TrimPrefix(bucket.Location, "oss-")
+			}
+			buckets[region] = append(buckets[region], bucket)
+		}
+		if !response.IsTruncated {
+			break
+		}
+		marker = response.NextMarker
+	}
+	return buckets, nil
+}
+
+// describeOSSBucket 查询单个 Bucket 的权限、版本控制、加密及容量信息
+func describeOSSBucket(client *oss.Client, bucket oss.BucketProperties) (database.OSSBucketRecord, error) {
+	rec := database.OSSBucketRecord{
+		BucketName:   bucket.Name,
+		StorageClass: bucket.StorageClass,
+		CreateTime:   bucket.CreationDate.Local().Format("2006-01-02 15:04:05"),
+	}
+
+	// 基本信息中包含 ACL、版本控制及加密配置
+	info, err := client.GetBucketInfo(bucket.Name)
+	if err != nil {
+		return rec, fmt.Errorf("获取 OSS Bucket 信息失败 (BucketName=%s): %w", bucket.Name, err)
+	}
+	rec.ACL = info.BucketInfo.ACL
+	rec.RedundancyType = info.BucketInfo.RedundancyType
+	rec.Versioning = info.BucketInfo.Versioning
+	rec.Encryption = info.BucketInfo.SseRule.SSEAlgorithm
+	rec.ExtranetEndpoint = info.BucketInfo.ExtranetEndpoint
+	rec.IntranetEndpoint = info.BucketInfo.IntranetEndpoint
+	if rec.Encryption == "None" {
+		rec.Encryption = ""
+	}
+
+	// 以下信息依赖额外权限或功能开通，获取失败时仅记录日志
+	stat, err := client.GetBucketStat(bucket.Name)
+	if err != nil {
+		logger.Log.Warnf("获取 OSS Bucket 容量失败 (BucketName=%s): %v", bucket.Name, err)
+	} else {
+		rec.StorageSize = stat.Storage
+		rec.ObjectCount = stat.ObjectCount
+	}
+
+	var block ossPublicAccessBlock
+	if err := getOSSSubResource(client, bucket.Name, "publicAccessBlock", &block); err != nil {
+		logger.Log.Debugf("获取 OSS 阻止公共访问配置失败 (BucketName=%s): %v", bucket.Name, err)
+	}
+	rec.BlockPublicAccess = block.BlockPublicAccess
+
+	var policy ossPolicyStatus
+	if err := getOSSSubResource(client, bucket.Name, "policyStatus", &policy); err != nil {
+		logger.Log.Debugf("获取 OSS Bucket Policy 状态失败 (BucketName=%s): %v", bucket.Name, err)
+	}
+	rec.PolicyPublic = policy.IsPublic
+
+	return rec, nil
+}
+
+// getOSSSubResource 通过通用请求读取 Bucket 子资源并解析 XML 响应
+func getOSSSubResource(client *oss.Client, bucketName, subResource string, out interface{}) error {
+	params := map[string]interface{}{subResource: nil}
+	response, err := client.Conn.Do("GET", bucketName, "", params, nil, nil, 0, nil)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	return xml.Unmarshal(body, out)
+}
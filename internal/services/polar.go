@@ -55,15 +55,49 @@ func SyncPolarDBInfo(accountName string, polarRegionIds []string, accessKey stri
 					if err != nil {
 						return fmt.Errorf("获取 PolarDB 连接信息失败 (DBClusterID=%s): %w", cluster.DBClusterId, err)
 					}
-					// 收集所有连接地址并用逗号拼接
+					// 收集所有连接地址并用逗号拼接，同时按地址保存结构化的连接信息
 					var addrList []string
+					var endpoints []database.PolarDBEndpointRecord
 					for _, ep := range epResp.Items {
 						for _, addr := range ep.AddressItems {
 							addrList = append(addrList, addr.ConnectionString)
+							endpoints = append(endpoints, database.PolarDBEndpointRecord{
+								EndpointID:       ep.DBEndpointId,
+								EndpointType:     ep.EndpointType,
+								ReadWriteMode:    ep.ReadWriteMode,
+								NetType:          addr.NetType,
+								ConnectionString: addr.ConnectionString,
+								Port:             addr.Port,
+								IPAddress:        addr.IPAddress,
+								VPCID:            addr.VPCId,
+								VSwitchID:        addr.VSwitchId,
+								Description:      ep.DBEndpointDescription,
+							})
 						}
 					}
 					connectionStr := strings.Join(addrList, ",")
 
+					// 列表接口返回的节点不含状态，从集群详情中获取节点信息
+					attrReq := polardb.CreateDescribeDBClusterAttributeRequest()
+					attrReq.DBClusterId = cluster.DBClusterId
+					attrResp, err := client.DescribeDBClusterAttribute(attrReq)
+					if err != nil {
+						return fmt.Errorf("获取 PolarDB 集群详情失败 (DBClusterID=%s): %w", cluster.DBClusterId, err)
+					}
+					var nodes []database.PolarDBNodeRecord
+					for _, node := range attrResp.DBNodes {
+						nodes = append(nodes, database.PolarDBNodeRecord{
+							NodeID:    node.DBNodeId,
+							NodeRole:  node.DBNodeRole,
+							NodeClass: node.DBNodeClass,
+							ZoneID:    node.ZoneId,
+							Status:    node.DBNodeStatus,
+						})
+					}
+					if err := database.SavePolarDBDetails(cluster.DBClusterId, nodes, endpoints); err != nil {
+						return fmt.Errorf("保存 PolarDB 节点及连接地址失败 (账户=%s): %w", accountName, err)
+					}
+
 					// 将 MemorySize 从 string 转换为 int64
 					memorySize, err := strconv.ParseInt(cluster.MemorySize, 10, 64)
 					if err != nil {
@@ -81,6 +115,8 @@ func SyncPolarDBInfo(accountName string, polarRegionIds []string, accessKey stri
 						Description:      cluster.DBClusterDescription,
 						MemorySize:       memorySize,
 						ConnectionString: connectionStr,
+						VPCID:            cluster.VpcId,
+						VSwitchID:        cluster.VswitchId,
 					}
 					records = append(records, rec)
 				}
@@ -93,7 +129,7 @@ func SyncPolarDBInfo(accountName string, polarRegionIds []string, accessKey stri
 				pageNumber++
 			}
 			// 保存 PolarDB 数据
-			if err := database.SavePolarDBRecords(records); err != nil {
+			if err := database.SavePolarDBRecords(accountName, regionID, records); err != nil {
 				return fmt.Errorf("保存 PolarDB 数据失败 (账户=%s): %w", accountName, err)
 			}
 
@@ -0,0 +1,319 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+	"github.com/WillemCode/AliCloud_Resources/pkg/logger"
+
+	sdkerrors "github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ram"
+)
+
+// ramPageSize RAM 列表接口每页返回的条数（Marker 分页）
+const ramPageSize = 100
+
+// SyncRAMInfo 同步指定账户的 RAM 用户、用户组、角色、AccessKey 及权限策略授权（全局服务，区域仅用于选择接入点）
+func SyncRAMInfo(accountName string, ramRegionIds []string, accessKey string, accessSecret string) error {
+	for _, regionID := range ramRegionIds {
+		if regionID != "nil" && regionID != "" {
+			logger.Log.Infof("开始同步信息, 区域=%s, 资源=RAM, 账户=%s", regionID, accountName)
+
+			// 初始化 RAM 客户端
+			client, err := ram.NewClientWithAccessKey(regionID, accessKey, accessSecret)
+			if err != nil {
+				return fmt.Errorf("RAM 客户端初始化失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+			}
+
+			users, err := listRAMUsers(client)
+			if err != nil {
+				return fmt.Errorf("RAM API 调用失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+			}
+			logger.Log.Infof("数据查询完成, 区域=%s, 资源=RAM, 账户=%s, 总数=%d 条", regionID, accountName, len(users))
+
+			// 已绑定虚拟 MFA 设备的用户
+			mfaUsers, err := listRAMMFAUsers(client)
+			if err != nil {
+				return err
+			}
+
+			var userRecords []database.RAMUserRecord
+			var accessKeys []database.RAMAccessKeyRecord
+			var attachments []database.RAMPolicyAttachmentRecord
+			for _, user := range users {
+				rec := database.RAMUserRecord{
+					UserID:        user.UserId,
+					CloudName:     accountName,
+					UserName:      user.UserName,
+					DisplayName:   user.DisplayName,
+					Comments:      user.Comments,
+					MFAEnabled:    mfaUsers[user.UserName],
+					CreateDate:    user.CreateDate,
+					LastLoginDate: user.LastLoginDate,
+				}
+				if rec.ConsoleLogin, err = ramConsoleLogin(client, user.UserName); err != nil {
+					return err
+				}
+				if rec.Groups, err = listRAMGroupsForUser(client, user.UserName); err != nil {
+					return err
+				}
+				userRecords = append(userRecords, rec)
+
+				keys, err := listRAMAccessKeys(client, accountName, user.UserName)
+				if err != nil {
+					return err
+				}
+				accessKeys = append(accessKeys, keys...)
+
+				policies, err := listRAMPolicies(client, database.RAMPrincipalUser, user.UserName)
+				if err != nil {
+					return err
+				}
+				attachments = append(attachments, policies...)
+			}
+
+			groups, err := listRAMGroups(client, accountName)
+			if err != nil {
+				return err
+			}
+			for _, group := range groups {
+				policies, err := listRAMPolicies(client, database.RAMPrincipalGroup, group.GroupName)
+				if err != nil {
+					return err
+				}
+				attachments = append(attachments, policies...)
+			}
+
+			roles, err := listRAMRoles(client, accountName)
+			if err != nil {
+				return err
+			}
+			for _, role := range roles {
+				policies, err := listRAMPolicies(client, database.RAMPrincipalRole, role.RoleName)
+				if err != nil {
+					return err
+				}
+				attachments = append(attachments, policies...)
+			}
+
+			// 保存数据
+			if err := database.SaveRAMDetails(accountName, userRecords, groups, roles, accessKeys, attachments); err != nil {
+				return fmt.Errorf("保存 RAM 数据失败 (账户=%s): %w", accountName, err)
+			}
+
+			logger.Log.Infof("数据同步完成, 区域=%s, 资源=RAM, 账户=%s, 同步=%d 条", regionID, accountName, len(userRecords))
+		} else {
+			logger.Log.Warnf("当前阿里账户, 区域=%s, 资源=RAM, 账户=%s, 暂无可用区域。", regionID, accountName)
+		}
+	}
+	return nil
+}
+
+// listRAMUsers 分页查询全部 RAM 用户
+func listRAMUsers(client *ram.Client) ([]ram.User, error) {
+	var users []ram.User
+	marker := ""
+	for {
+		request := ram.CreateListUsersRequest()
+		request.MaxItems = requests.NewInteger(ramPageSize)
+		request.Marker = marker
+
+		response, err := client.ListUsers(request)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, response.Users.User...)
+		if !response.IsTruncated {
+			break
+		}
+		marker = response.Marker
+	}
+	return users, nil
+}
+
+// listRAMMFAUsers 查询已绑定虚拟 MFA 设备的用户名
+func listRAMMFAUsers(client *ram.Client) (map[string]bool, error) {
+	response, err := client.ListVirtualMFADevices(ram.CreateListVirtualMFADevicesRequest())
+	if err != nil {
+		return nil, fmt.Errorf("获取 RAM MFA 设备失败: %w", err)
+	}
+	users := map[string]bool{}
+	for _, device := range response.VirtualMFADevices.VirtualMFADevice {
+		if device.User.UserName != "" {
+			users[device.User.UserName] = true
+		}
+	}
+	return users, nil
+}
+
+// ramConsoleLogin 判断用户是否开启控制台登录，未开启时接口返回 EntityNotExist.User.LoginProfile
+func ramConsoleLogin(client *ram.Client, userName string) (bool, error) {
+	request := ram.CreateGetLoginProfileRequest()
+	request.UserName = userName
+	if _, err := client.GetLoginProfile(request); err != nil {
+		var serverErr *sdkerrors.ServerError
+		if errors.As(err, &serverErr) && strings.HasPrefix(serverErr.ErrorCode(), "EntityNotExist") {
+			return false, nil
+		}
+		return false, fmt.Errorf("获取 RAM 用户登录配置失败 (UserName=%s): %w", userName, err)
+	}
+	return true, nil
+}
+
+// listRAMGroupsForUser 查询用户所属的用户组，以逗号分隔返回
+func listRAMGroupsForUser(client *ram.Client, userName string) (string, error) {
+	request := ram.CreateListGroupsForUserRequest()
+	request.UserName = userName
+	response, err := client.ListGroupsForUser(request)
+	if err != nil {
+		return "", fmt.Errorf("获取 RAM 用户所属用户组失败 (UserName=%s): %w", userName, err)
+	}
+	var names []string
+	for _, group := range response.Groups.Group {
+		names = append(names, group.GroupName)
+	}
+	return strings.Join(names, ","), nil
+}
+
+// listRAMAccessKeys 查询用户的 AccessKey 及最后使用时间，不保存 Secret
+func listRAMAccessKeys(client *ram.Client, accountName, userName string) ([]database.RAMAccessKeyRecord, error) {
+	request := ram.CreateListAccessKeysRequest()
+	request.UserName = userName
+	response, err := client.ListAccessKeys(request)
+	if err != nil {
+		return nil, fmt.Errorf("获取 RAM 用户 AccessKey 失败 (UserName=%s): %w", userName, err)
+	}
+
+	var records []database.RAMAccessKeyRecord
+	for _, key := range response.AccessKeys.AccessKey {
+		rec := database.RAMAccessKeyRecord{
+			AccessKeyID: key.AccessKeyId,
+			CloudName:   accountName,
+			UserName:    userName,
+			Status:      key.Status,
+			CreateDate:  key.CreateDate,
+		}
+		// 最后使用时间查询失败不影响 AccessKey 本身的同步
+		lastUsedRequest := ram.CreateGetAccessKeyLastUsedRequest()
+		lastUsedRequest.UserName = userName
+		lastUsedRequest.UserAccessKeyId = key.AccessKeyId
+		lastUsed, err := client.GetAccessKeyLastUsed(lastUsedRequest)
+		if err != nil {
+			logger.Log.Warnf("获取 AccessKey 最后使用时间失败 (AccessKeyID=%s): %v", key.AccessKeyId, err)
+			rec.LastUsedUnknown = true
+		} else {
+			rec.LastUsedDate = lastUsed.AccessKeyLastUsed.LastUsedDate
+		}
+		records = append(records, rec)
+	}
+	return records, nil
+}
+
+// listRAMGroups 分页查询全部用户组
+func listRAMGroups(client *ram.Client, accountName string) ([]database.RAMGroupRecord, error) {
+	var records []database.RAMGroupRecord
+	marker := ""
+	for {
+		request := ram.CreateListGroupsRequest()
+		request.MaxItems = requests.NewInteger(ramPageSize)
+		request.Marker = marker
+
+		response, err := client.ListGroups(request)
+		if err != nil {
+			return nil, fmt.Errorf("获取 RAM 用户组失败 (账户=%s): %w", accountName, err)
+		}
+		for _, group := range response.Groups.Group {
+			records = append(records, database.RAMGroupRecord{
+				GroupID:    group.GroupId,
+				CloudName:  accountName,
+				GroupName:  group.GroupName,
+				Comments:   group.Comments,
+				CreateDate: group.CreateDate,
+			})
+		}
+		if !response.IsTruncated {
+			break
+		}
+		marker = response.Marker
+	}
+	return records, nil
+}
+
+// listRAMRoles 分页查询全部角色
+func listRAMRoles(client *ram.Client, accountName string) ([]database.RAMRoleRecord, error) {
+	var records []database.RAMRoleRecord
+	marker := ""
+	for {
+		request := ram.CreateListRolesRequest()
+		request.MaxItems = requests.NewInteger(ramPageSize)
+		request.Marker = marker
+
+		response, err := client.ListRoles(request)
+		if err != nil {
+			return nil, fmt.Errorf("获取 RAM 角色失败 (账户=%s): %w", accountName, err)
+		}
+		for _, role := range response.Roles.Role {
+			records = append(records, database.RAMRoleRecord{
+				RoleID:             role.RoleId,
+				CloudName:          accountName,
+				RoleName:           role.RoleName,
+				Arn:                role.Arn,
+				Description:        role.Description,
+				MaxSessionDuration: role.MaxSessionDuration,
+				CreateDate:         role.CreateDate,
+			})
+		}
+		if !response.IsTruncated {
+			break
+		}
+		marker = response.Marker
+	}
+	return records, nil
+}
+
+// listRAMPolicies 查询用户、用户组或角色被授予的权限策略
+func listRAMPolicies(client *ram.Client, principalType, principalName string) ([]database.RAMPolicyAttachmentRecord, error) {
+	var policies []ram.Policy
+	var err error
+	switch principalType {
+	case database.RAMPrincipalUser:
+		request := ram.CreateListPoliciesForUserRequest()
+		request.UserName = principalName
+		var response *ram.ListPoliciesForUserResponse
+		if response, err = client.ListPoliciesForUser(request); err == nil {
+			policies = response.Policies.Policy
+		}
+	case database.RAMPrincipalGroup:
+		request := ram.CreateListPoliciesForGroupRequest()
+		request.GroupName = principalName
+		var response *ram.ListPoliciesForGroupResponse
+		if response, err = client.ListPoliciesForGroup(request); err == nil {
+			policies = response.Policies.Policy
+		}
+	case database.RAMPrincipalRole:
+		request := ram.CreateListPoliciesForRoleRequest()
+		request.RoleName = principalName
+		var response *ram.ListPoliciesForRoleResponse
+		if response, err = client.ListPoliciesForRole(request); err == nil {
+			policies = response.Policies.Policy
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("获取 RAM 权限策略失败 (%s=%s): %w", principalType, principalName, err)
+	}
+
+	var records []database.RAMPolicyAttachmentRecord
+	for _, policy := range policies {
+		records = append(records, database.RAMPolicyAttachmentRecord{
+			PrincipalType: principalType,
+			PrincipalName: principalName,
+			PolicyName:    policy.PolicyName,
+			PolicyType:    policy.PolicyType,
+			AttachDate:    policy.AttachDate,
+		})
+	}
+	return records, nil
+}
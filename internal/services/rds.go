@@ -63,6 +63,18 @@ func SyncRDSInfo(accountName string, rdsRegionIds []string, accessKey string, ac
 					}
 					connectionStr := strings.Join(addressList, ",")
 
+					// 列表接口不返回存储空间，需要查询实例详情
+					attrReq := rds.CreateDescribeDBInstanceAttributeRequest()
+					attrReq.DBInstanceId = instance.DBInstanceId
+					attrResp, err := client.DescribeDBInstanceAttribute(attrReq)
+					if err != nil {
+						return fmt.Errorf("获取 RDS 实例详情失败 (InstanceID=%s): %w", instance.DBInstanceId, err)
+					}
+					var storageSize int64
+					if len(attrResp.Items.DBInstanceAttribute) > 0 {
+						storageSize = int64(attrResp.Items.DBInstanceAttribute[0].DBInstanceStorage)
+					}
+
 					// 构造 RDSRecord
 					rec := database.RDSRecord{
 						InstanceID:       instance.DBInstanceId,
@@ -73,6 +85,14 @@ func SyncRDSInfo(accountName string, rdsRegionIds []string, accessKey string, ac
 						Memory:           int64(instance.DBInstanceMemory),
 						Description:      instance.DBInstanceDescription,
 						ConnectionString: connectionStr,
+						VPCID:            instance.VpcId,
+						VSwitchID:        instance.VSwitchId,
+						EngineVersion:    instance.EngineVersion,
+						InstanceClass:    instance.DBInstanceClass,
+						StorageSize:      storageSize,
+						StorageType:      instance.DBInstanceStorageType,
+						ZoneID:           instance.ZoneId,
+						HAMode:           instance.Category,
 					}
 					records = append(records, rec)
 				}
@@ -85,10 +105,17 @@ func SyncRDSInfo(accountName string, rdsRegionIds []string, accessKey string, ac
 				pageNumber++
 			}
 			// 保存 RDS 数据
-			if err := database.SaveRDSRecords(records); err != nil {
+			if err := database.SaveRDSRecords(accountName, regionID, records); err != nil {
 				return fmt.Errorf("保存 RDS 数据失败 (账户=%s): %w", accountName, err)
 			}
 
+			// 逐个实例同步数据库、账号及白名单
+			for _, rec := range records {
+				if err := syncRDSDetails(client, rec.InstanceID); err != nil {
+					return fmt.Errorf("同步 RDS 数据库及账号失败 (账户=%s): %w", accountName, err)
+				}
+			}
+
 			logger.Log.Infof("数据同步完成, 区域=%s, 资源=RDS, 账户=%s, 同步=%d 条", regionID, accountName, len(records))
 		} else {
 			logger.Log.Warnf("当前阿里账户, 区域=%s, 资源=RDS, 账户=%s, 暂无可用区域。", regionID, accountName)
@@ -96,3 +123,88 @@ func SyncRDSInfo(accountName string, rdsRegionIds []string, accessKey string, ac
 	}
 	return nil
 }
+
+// syncRDSDetails 同步单个 RDS 实例的数据库、账号及授权、IP 白名单分组
+func syncRDSDetails(client *rds.Client, instanceID string) error {
+	pageSize := 100
+
+	// 1) 数据库
+	var databases []database.RDSDatabaseRecord
+	for pageNumber := 1; ; pageNumber++ {
+		request := rds.CreateDescribeDatabasesRequest()
+		request.DBInstanceId = instanceID
+		request.PageSize = requests.NewInteger(pageSize)
+		request.PageNumber = requests.NewInteger(pageNumber)
+
+		response, err := client.DescribeDatabases(request)
+		if err != nil {
+			return fmt.Errorf("获取 RDS 数据库失败 (InstanceID=%s): %w", instanceID, err)
+		}
+		for _, d := range response.Databases.Database {
+			databases = append(databases, database.RDSDatabaseRecord{
+				DBName:       d.DBName,
+				Engine:       d.Engine,
+				CharacterSet: d.CharacterSetName,
+				Status:       d.DBStatus,
+				Description:  d.DBDescription,
+			})
+		}
+		if len(response.Databases.Database) < pageSize {
+			break
+		}
+	}
+
+	// 2) 账号及其授权的数据库
+	var accounts []database.RDSAccountRecord
+	var privileges []database.RDSAccountPrivilegeRecord
+	for pageNumber := 1; ; pageNumber++ {
+		request := rds.CreateDescribeAccountsRequest()
+		request.DBInstanceId = instanceID
+		request.PageSize = requests.NewInteger(pageSize)
+		request.PageNumber = requests.NewInteger(pageNumber)
+
+		response, err := client.DescribeAccounts(request)
+		if err != nil {
+			return fmt.Errorf("获取 RDS 账号失败 (InstanceID=%s): %w", instanceID, err)
+		}
+		for _, a := range response.Accounts.DBInstanceAccount {
+			accounts = append(accounts, database.RDSAccountRecord{
+				AccountName: a.AccountName,
+				AccountType: a.AccountType,
+				Status:      a.AccountStatus,
+				Description: a.AccountDescription,
+			})
+			for _, p := range a.DatabasePrivileges.DatabasePrivilege {
+				privileges = append(privileges, database.RDSAccountPrivilegeRecord{
+					AccountName:     a.AccountName,
+					DBName:          p.DBName,
+					Privilege:       p.AccountPrivilege,
+					PrivilegeDetail: p.AccountPrivilegeDetail,
+				})
+			}
+		}
+		if len(response.Accounts.DBInstanceAccount) < pageSize {
+			break
+		}
+	}
+
+	// 3) IP 白名单分组
+	whitelistReq := rds.CreateDescribeDBInstanceIPArrayListRequest()
+	whitelistReq.DBInstanceId = instanceID
+	whitelistResp, err := client.DescribeDBInstanceIPArrayList(whitelistReq)
+	if err != nil {
+		return fmt.Errorf("获取 RDS 白名单失败 (InstanceID=%s): %w", instanceID, err)
+	}
+	var whitelists []database.RDSWhitelistRecord
+	for _, w := range whitelistResp.Items.DBInstanceIPArray {
+		whitelists = append(whitelists, database.RDSWhitelistRecord{
+			GroupName:   w.DBInstanceIPArrayName,
+			IPType:      w.SecurityIPType,
+			NetworkType: w.WhitelistNetworkType,
+			Attribute:   w.DBInstanceIPArrayAttribute,
+			IPList:      w.SecurityIPList,
+		})
+	}
+
+	return database.SaveRDSDetails(instanceID, databases, accounts, privileges, whitelists)
+}
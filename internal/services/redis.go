@@ -57,17 +57,41 @@ func SyncRedisInfo(accountName string, redisRegionIds []string, accessKey string
 					if err != nil {
 						return fmt.Errorf("获取 Tair Redis 网络信息失败 (InstanceID=%s): %w", instance.InstanceId, err)
 					}
-					// 收集所有连接地址并用逗号拼接
+					// 收集所有连接地址并用逗号拼接，同时按地址保存 IP 与连接串的对应关系
 					var addressList []string
 					var connectionList []string
-					for _, addInfo := range tairResp.NetInfoItems.InstanceNetInfo {
-						addressList = append(addressList, addInfo.IPAddress)
-					}
-					for _, conInfo := range tairResp.NetInfoItems.InstanceNetInfo {
-						connectionList = append(connectionList, conInfo.ConnectionString)
+					var endpoints []database.RedisEndpointRecord
+					for _, netInfo := range tairResp.NetInfoItems.InstanceNetInfo {
+						addressList = append(addressList, netInfo.IPAddress)
+						connectionList = append(connectionList, netInfo.ConnectionString)
+						endpoints = append(endpoints, database.RedisEndpointRecord{
+							ConnectionString: netInfo.ConnectionString,
+							IPAddress:        netInfo.IPAddress,
+							Port:             netInfo.Port,
+							NetType:          netInfo.DBInstanceNetType,
+							IPType:           netInfo.IPType,
+							VPCID:            netInfo.VPCId,
+							VSwitchID:        netInfo.VSwitchId,
+							ExpiredTime:      netInfo.ExpiredTime,
+						})
 					}
 					addressStr := strings.Join(addressList, ",")
 					connectionStr := strings.Join(connectionList, ",")
+					if err := database.SaveRedisEndpoints(instance.InstanceId, endpoints); err != nil {
+						return fmt.Errorf("保存 Tair Redis 连接地址失败 (账户=%s): %w", accountName, err)
+					}
+
+					// 集群架构通过逻辑拓扑统计分片数，其余架构只有一个分片
+					shardCount := int64(1)
+					if instance.ArchitectureType == "cluster" {
+						topoReq := r_kvstore.CreateDescribeLogicInstanceTopologyRequest()
+						topoReq.InstanceId = instance.InstanceId
+						topoResp, err := client.DescribeLogicInstanceTopology(topoReq)
+						if err != nil {
+							return fmt.Errorf("获取 Tair Redis 拓扑失败 (InstanceID=%s): %w", instance.InstanceId, err)
+						}
+						shardCount = int64(len(topoResp.RedisShardList.NodeInfo))
+					}
 
 					// 构造 ECSRecord
 					rec := database.RedisRecord{
@@ -84,6 +108,13 @@ func SyncRedisInfo(accountName string, redisRegionIds []string, accessKey string
 						InstanceType:     instance.InstanceType,
 						ConnectionString: connectionStr,
 						IPAddress:        addressStr,
+						VPCID:            instance.VpcId,
+						VSwitchID:        instance.VSwitchId,
+						EngineVersion:    instance.EngineVersion,
+						Architecture:     instance.ArchitectureType,
+						ShardCount:       shardCount,
+						ZoneID:           instance.ZoneId,
+						Status:           instance.InstanceStatus,
 					}
 					records = append(records, rec)
 				}
@@ -97,7 +128,7 @@ func SyncRedisInfo(accountName string, redisRegionIds []string, accessKey string
 			}
 
 			// 调用数据库包保存 ECS 数据
-			if err := database.SaveRedisRecords(records); err != nil {
+			if err := database.SaveRedisRecords(accountName, regionID, records); err != nil {
 				return fmt.Errorf("保存 Tair Redis 数据失败 (账户=%s): %w", accountName, err)
 			}
 			logger.Log.Infof("数据同步完成, 区域=%s, 资源=Tair, 账户=%s, 同步=%d 条", regionID, accountName, len(records))
@@ -0,0 +1,130 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+	"github.com/WillemCode/AliCloud_Resources/pkg/logger"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+)
+
+// SyncSecurityGroupInfo 同步指定账户和区域的安全组及其出入方向规则
+func SyncSecurityGroupInfo(accountName string, ecsRegionIds []string, accessKey string, accessSecret string) error {
+	for _, regionID := range ecsRegionIds {
+		if regionID != "nil" && regionID != "" {
+			logger.Log.Infof("开始同步信息, 区域=%s, 资源=安全组, 账户=%s", regionID, accountName)
+
+			// 安全组属于 ECS 产品，复用 ECS 客户端
+			client, err := ecs.NewClientWithAccessKey(regionID, accessKey, accessSecret)
+			if err != nil {
+				return fmt.Errorf("ECS客户端初始化失败 (区域=%s, 账户=%s): %w", regionID, accountName, err)
+			}
+
+			// 分页请求数据
+			pageSize := 50  // 每页返回的条数（DescribeSecurityGroups 最大 50）
+			pageNumber := 1 // 从第一页开始
+			totalCount := 0 // 总条数
+			var records []database.SecurityGroupRecord
+			for {
+				request := ecs.CreateDescribeSecurityGroupsRequest()
+				request.PageSize = requests.NewInteger(pageSize)     // 设置每页最大条数
+				request.PageNumber = requests.NewInteger(pageNumber) // 设置当前页数
+
+				response, err := client.DescribeSecurityGroups(request)
+				if err != nil {
+					return fmt.Errorf("安全组 API 调用失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+				}
+
+				// 获取总数
+				if totalCount == 0 {
+					totalCount = response.TotalCount
+					logger.Log.Infof("数据查询完成, 区域=%s, 资源=安全组, 账户=%s, 总数=%d 条", regionID, accountName, totalCount)
+				}
+
+				for _, group := range response.SecurityGroups.SecurityGroup {
+					records = append(records, database.SecurityGroupRecord{
+						SecurityGroupID:   group.SecurityGroupId,
+						CloudName:         accountName,
+						SecurityGroupName: group.SecurityGroupName,
+						SecurityGroupType: group.SecurityGroupType,
+						RegionID:          regionID,
+						VPCID:             group.VpcId,
+						Description:       group.Description,
+					})
+				}
+				// 如果返回的数据条数小于 pageSize，说明已经拉取到最后一页，退出循环
+				if len(response.SecurityGroups.SecurityGroup) < pageSize {
+					break
+				}
+
+				// 请求下一页数据
+				pageNumber++
+			}
+
+			// 保存安全组数据
+			if err := database.SaveSecurityGroupRecords(accountName, regionID, records); err != nil {
+				return fmt.Errorf("保存安全组数据失败 (账户=%s): %w", accountName, err)
+			}
+
+			// 逐个安全组拉取出入方向规则
+			for _, rec := range records {
+				rules, err := describeSecurityGroupRules(client, regionID, rec.SecurityGroupID)
+				if err != nil {
+					return err
+				}
+				if err := database.SaveSecurityGroupRules(rec.SecurityGroupID, rules); err != nil {
+					return fmt.Errorf("保存安全组规则失败 (账户=%s): %w", accountName, err)
+				}
+			}
+
+			logger.Log.Infof("数据同步完成, 区域=%s, 资源=安全组, 账户=%s, 同步=%d 条", regionID, accountName, len(records))
+		} else {
+			logger.Log.Warnf("当前阿里账户, 区域=%s, 资源=安全组, 账户=%s, 暂无可用区域。", regionID, accountName)
+		}
+	}
+	return nil
+}
+
+// describeSecurityGroupRules 获取单个安全组的全部出入方向规则
+func describeSecurityGroupRules(client *ecs.Client, regionID string, securityGroupID string) ([]database.SecurityGroupRuleRecord, error) {
+	request := ecs.CreateDescribeSecurityGroupAttributeRequest()
+	request.RegionId = regionID
+	request.SecurityGroupId = securityGroupID
+	request.Direction = "all" // 同时返回入方向和出方向规则
+
+	response, err := client.DescribeSecurityGroupAttribute(request)
+	if err != nil {
+		return nil, fmt.Errorf("获取安全组规则失败 (SecurityGroupID=%s): %w", securityGroupID, err)
+	}
+
+	var rules []database.SecurityGroupRuleRecord
+	for _, perm := range response.Permissions.Permission {
+		// IPv6 规则的地址段记录在单独的字段中
+		sourceCidr := perm.SourceCidrIp
+		if sourceCidr == "" {
+			sourceCidr = perm.Ipv6SourceCidrIp
+		}
+		destCidr := perm.DestCidrIp
+		if destCidr == "" {
+			destCidr = perm.Ipv6DestCidrIp
+		}
+		rules = append(rules, database.SecurityGroupRuleRecord{
+			SecurityGroupID: securityGroupID,
+			RuleID:          perm.SecurityGroupRuleId,
+			Direction:       perm.Direction,
+			Policy:          perm.Policy,
+			Priority:        perm.Priority,
+			IPProtocol:      perm.IpProtocol,
+			PortRange:       perm.PortRange,
+			SourceCidrIP:    sourceCidr,
+			SourceGroupID:   perm.SourceGroupId,
+			DestCidrIP:      destCidr,
+			DestGroupID:     perm.DestGroupId,
+			NicType:         perm.NicType,
+			Description:     perm.Description,
+		})
+	}
+	return rules, nil
+}
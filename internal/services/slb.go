@@ -2,6 +2,7 @@ package services
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/WillemCode/AliCloud_Resources/pkg/database"
 	"github.com/WillemCode/AliCloud_Resources/pkg/logger"
@@ -57,6 +58,8 @@ func SyncSLBInfo(accountName string, slbRegionIds []string, accessKey string, ac
 						NetworkType:      lb.NetworkType,
 						RegionID:         lb.RegionId,
 						Status:           lb.LoadBalancerStatus,
+						VPCID:            lb.VpcId,
+						VSwitchID:        lb.VSwitchId,
 					}
 					records = append(records, rec)
 				}
@@ -69,10 +72,17 @@ func SyncSLBInfo(accountName string, slbRegionIds []string, accessKey string, ac
 				pageNumber++
 			}
 			// 保存 SLB 数据
-			if err := database.SaveSLBRecords(records); err != nil {
+			if err := database.SaveSLBRecords(accountName, regionID, records); err != nil {
 				return fmt.Errorf("保存 SLB 数据失败 (账户=%s): %w", accountName, err)
 			}
 
+			// 逐个实例同步监听及后端服务器
+			for _, rec := range records {
+				if err := syncSLBListeners(client, rec.InstanceID); err != nil {
+					return fmt.Errorf("同步 SLB 监听失败 (账户=%s): %w", accountName, err)
+				}
+			}
+
 			logger.Log.Infof("数据同步完成, 区域=%s, 资源=CLB, 账户=%s, 同步=%d 条", regionID, accountName, len(records))
 		} else {
 			logger.Log.Warnf("当前阿里账户, 区域=%s, 资源=CLB, 账户=%s, 暂无可用区域。", regionID, accountName)
@@ -80,3 +90,136 @@ func SyncSLBInfo(accountName string, slbRegionIds []string, accessKey string, ac
 	}
 	return nil
 }
+
+// syncSLBListeners 同步单个 CLB 实例的监听、默认服务器组及虚拟服务器组
+func syncSLBListeners(client *slb.Client, lbID string) error {
+	// 1) 监听列表（NextToken 分页）
+	var listeners []database.LBListenerRecord
+	nextToken := ""
+	for {
+		request := slb.CreateDescribeLoadBalancerListenersRequest()
+		request.LoadBalancerId = &[]string{lbID}
+		request.MaxResults = requests.NewInteger(100)
+		request.NextToken = nextToken
+
+		response, err := client.DescribeLoadBalancerListeners(request)
+		if err != nil {
+			return fmt.Errorf("获取 SLB 监听失败 (LoadBalancerID=%s): %w", lbID, err)
+		}
+		for _, l := range response.Listeners {
+			healthCheck, certificateID := clbListenerDetail(l)
+			// 未指定虚拟服务器组的监听转发到默认服务器组
+			serverGroupID := l.VServerGroupId
+			if serverGroupID == "" {
+				serverGroupID = database.CLBDefaultServerGroupID(lbID)
+			}
+			listeners = append(listeners, database.LBListenerRecord{
+				ListenerID:    fmt.Sprintf("%s-%s-%d", lbID, l.ListenerProtocol, l.ListenerPort),
+				LBType:        database.LBTypeCLB,
+				LBID:          lbID,
+				ListenerPort:  int64(l.ListenerPort),
+				Protocol:      l.ListenerProtocol,
+				BackendPort:   int64(l.BackendServerPort),
+				ServerGroupID: serverGroupID,
+				Status:        l.Status,
+				HealthCheck:   healthCheck,
+				CertificateID: certificateID,
+				Description:   l.Description,
+			})
+		}
+		if response.NextToken == "" {
+			break
+		}
+		nextToken = response.NextToken
+	}
+	if err := database.SaveLBListeners(lbID, listeners); err != nil {
+		return err
+	}
+
+	// 清理旧的服务器组，避免已删除的虚拟服务器组残留
+	if err := database.DeleteLBServerGroups(lbID); err != nil {
+		return err
+	}
+
+	// 2) 默认服务器组（后端端口使用监听配置的后端端口）
+	attrReq := slb.CreateDescribeLoadBalancerAttributeRequest()
+	attrReq.LoadBalancerId = lbID
+	attrResp, err := client.DescribeLoadBalancerAttribute(attrReq)
+	if err != nil {
+		return fmt.Errorf("获取 SLB 实例详情失败 (LoadBalancerID=%s): %w", lbID, err)
+	}
+	var defaultServers []database.LBBackendServerRecord
+	for _, server := range attrResp.BackendServers.BackendServer {
+		defaultServers = append(defaultServers, database.LBBackendServerRecord{
+			ServerID:    server.ServerId,
+			ServerType:  server.Type,
+			ServerIP:    server.ServerIp,
+			Weight:      int64(server.Weight),
+			Description: server.Description,
+		})
+	}
+	defaultGroup := database.LBServerGroupRecord{
+		ServerGroupID:   database.CLBDefaultServerGroupID(lbID),
+		LBType:          database.LBTypeCLB,
+		LBID:            lbID,
+		ServerGroupName: "default",
+	}
+	if err := database.SaveLBServerGroup(defaultGroup, defaultServers); err != nil {
+		return err
+	}
+
+	// 3) 虚拟服务器组
+	groupsReq := slb.CreateDescribeVServerGroupsRequest()
+	groupsReq.LoadBalancerId = lbID
+	groupsResp, err := client.DescribeVServerGroups(groupsReq)
+	if err != nil {
+		return fmt.Errorf("获取 SLB 虚拟服务器组失败 (LoadBalancerID=%s): %w", lbID, err)
+	}
+	for _, group := range groupsResp.VServerGroups.VServerGroup {
+		groupReq := slb.CreateDescribeVServerGroupAttributeRequest()
+		groupReq.VServerGroupId = group.VServerGroupId
+		groupResp, err := client.DescribeVServerGroupAttribute(groupReq)
+		if err != nil {
+			return fmt.Errorf("获取 SLB 虚拟服务器组详情失败 (VServerGroupID=%s): %w", group.VServerGroupId, err)
+		}
+		var servers []database.LBBackendServerRecord
+		for _, server := range groupResp.BackendServers.BackendServer {
+			servers = append(servers, database.LBBackendServerRecord{
+				ServerID:    server.ServerId,
+				ServerType:  server.Type,
+				ServerIP:    server.ServerIp,
+				Port:        int64(server.Port),
+				Weight:      int64(server.Weight),
+				Description: server.Description,
+			})
+		}
+		groupRec := database.LBServerGroupRecord{
+			ServerGroupID:   group.VServerGroupId,
+			LBType:          database.LBTypeCLB,
+			LBID:            lbID,
+			ServerGroupName: group.VServerGroupName,
+		}
+		if err := database.SaveLBServerGroup(groupRec, servers); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// clbListenerDetail 根据监听协议取出健康检查开关和服务器证书ID
+func clbListenerDetail(l slb.ListenerInDescribeLoadBalancerListeners) (string, string) {
+	switch strings.ToLower(l.ListenerProtocol) {
+	case "http":
+		return l.HTTPListenerConfig.HealthCheck, ""
+	case "https":
+		return l.HTTPSListenerConfig.HealthCheck, l.HTTPSListenerConfig.ServerCertificateId
+	case "tcp":
+		return l.TCPListenerConfig.HealthCheck, ""
+	case "udp":
+		return l.UDPListenerConfig.HealthCheck, ""
+	case "tcpssl":
+		return l.TCPSListenerConfig.HealthCheck, l.TCPSListenerConfig.ServerCertificateId
+	default:
+		return "", ""
+	}
+}
@@ -0,0 +1,132 @@
+package services
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+	"github.com/WillemCode/AliCloud_Resources/pkg/logger"
+
+	sls "github.com/aliyun/aliyun-log-go-sdk"
+)
+
+// SyncSLSInfo 同步指定账户和区域的日志服务 Project 及 Logstore
+func SyncSLSInfo(accountName string, slsRegionIds []string, accessKey string, accessSecret string) error {
+	for _, regionID := range slsRegionIds {
+		if regionID != "nil" && regionID != "" {
+			logger.Log.Infof("开始同步信息, 区域=%s, 资源=SLS, 账户=%s", regionID, accountName)
+
+			// 初始化 SLS 客户端
+			endpoint := regionID + ".log.aliyuncs.com"
+			provider := sls.NewStaticCredentialsProvider(accessKey, accessSecret, "")
+			client := sls.CreateNormalInterfaceV2(endpoint, provider)
+
+			// 分页请求数据
+			var records []database.SLSProjectRecord
+			size := 100 // 每页返回的条数
+			offset := 0 // 偏移量
+			for {
+				projects, count, total, err := client.ListProjectV2(offset, size)
+				if err != nil {
+					client.Close()
+					return fmt.Errorf("SLS API 调用失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+				}
+
+				// 获取总数
+				if offset == 0 {
+					logger.Log.Infof("数据查询完成, 区域=%s, 资源=SLS, 账户=%s, 总数=%d 条", regionID, accountName, total)
+				}
+
+				for _, project := range projects {
+					records = append(records, database.SLSProjectRecord{
+						ProjectName:        project.Name,
+						CloudName:          accountName,
+						RegionID:           regionID,
+						Description:        project.Description,
+						Status:             project.Status,
+						DataRedundancyType: project.DataRedundancyType,
+						CreateTime:         formatUnixString(project.CreateTime),
+						ModifyTime:         formatUnixString(project.LastModifyTime),
+					})
+				}
+				// 如果返回的数据条数小于 size，说明已经拉取到最后一页，退出循环
+				if count < size {
+					break
+				}
+				offset += count
+			}
+			client.Close()
+
+			// 保存 Project 数据
+			if err := database.SaveSLSProjectRecords(accountName, regionID, records); err != nil {
+				return fmt.Errorf("保存 SLS 数据失败 (账户=%s): %w", accountName, err)
+			}
+
+			// 逐个 Project 同步 Logstore
+			for _, rec := range records {
+				project, err := sls.NewLogProjectV2(rec.ProjectName, endpoint, provider)
+				if err != nil {
+					return fmt.Errorf("SLS Project 客户端初始化失败 (Project=%s): %w", rec.ProjectName, err)
+				}
+				logstores, err := listSLSLogstores(project)
+				if err != nil {
+					return err
+				}
+				if err := database.SaveSLSLogstores(rec.ProjectName, logstores); err != nil {
+					return fmt.Errorf("保存 SLS Logstore 数据失败 (账户=%s): %w", accountName, err)
+				}
+			}
+
+			logger.Log.Infof("数据同步完成, 区域=%s, 资源=SLS, 账户=%s, 同步=%d 条", regionID, accountName, len(records))
+		} else {
+			logger.Log.Warnf("当前阿里账户, 区域=%s, 资源=SLS, 账户=%s, 暂无可用区域。", regionID, accountName)
+		}
+	}
+	return nil
+}
+
+// listSLSLogstores 分页查询 Project 下的 Logstore，并逐个获取保存时间及 Shard 配置
+func listSLSLogstores(project *sls.LogProject) ([]database.SLSLogstoreRecord, error) {
+	var records []database.SLSLogstoreRecord
+	size := 500
+	offset := 0
+	for {
+		names, err := project.ListLogStoreV2(offset, size, "")
+		if err != nil {
+			return nil, fmt.Errorf("获取 SLS Logstore 列表失败 (Project=%s): %w", project.Name, err)
+		}
+		for _, name := range names {
+			store, err := project.GetLogStore(name)
+			if err != nil {
+				return nil, fmt.Errorf("获取 SLS Logstore 详情失败 (Project=%s, Logstore=%s): %w", project.Name, name, err)
+			}
+			records = append(records, database.SLSLogstoreRecord{
+				ProjectName:   project.Name,
+				LogstoreName:  store.Name,
+				TTL:           int64(store.TTL),
+				HotTTL:        int64(store.HotTTL),
+				ShardCount:    int64(store.ShardCount),
+				AutoSplit:     store.AutoSplit,
+				MaxSplitShard: int64(store.MaxSplitShard),
+				Mode:          store.Mode,
+				TelemetryType: store.TelemetryType,
+				CreateTime:    formatMillis(int64(store.CreateTime) * 1000),
+				ModifyTime:    formatMillis(int64(store.LastModifyTime) * 1000),
+			})
+		}
+		if len(names) < size {
+			break
+		}
+		offset += len(names)
+	}
+	return records, nil
+}
+
+// formatUnixString 将秒级时间戳字符串格式化为本地时间，无法解析时原样返回
+func formatUnixString(s string) string {
+	sec, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return s
+	}
+	return formatMillis(sec * 1000)
+}
@@ -0,0 +1,371 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/WillemCode/AliCloud_Resources/pkg/database"
+	"github.com/WillemCode/AliCloud_Resources/pkg/logger"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+)
+
+// SyncVPCInfo 同步指定账户和区域的 VPC、交换机、路由表及 NAT 网关信息
+func SyncVPCInfo(accountName string, vpcRegionIds []string, accessKey string, accessSecret string) error {
+	for _, regionID := range vpcRegionIds {
+		if regionID != "nil" && regionID != "" {
+			logger.Log.Infof("开始同步信息, 区域=%s, 资源=VPC, 账户=%s", regionID, accountName)
+
+			// 初始化 VPC 客户端
+			client, err := vpc.NewClientWithAccessKey(regionID, accessKey, accessSecret)
+			if err != nil {
+				return fmt.Errorf("VPC 客户端初始化失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+			}
+
+			vpcCount, err := syncVPCs(client, accountName, regionID)
+			if err != nil {
+				return err
+			}
+			if err := syncVSwitches(client, accountName, regionID); err != nil {
+				return err
+			}
+			if err := syncRouteTables(client, accountName, regionID); err != nil {
+				return err
+			}
+			if err := syncNatGateways(client, accountName, regionID); err != nil {
+				return err
+			}
+
+			logger.Log.Infof("数据同步完成, 区域=%s, 资源=VPC, 账户=%s, 同步=%d 条", regionID, accountName, vpcCount)
+		} else {
+			logger.Log.Warnf("当前阿里账户, 区域=%s, 资源=VPC, 账户=%s, 暂无可用区域。", regionID, accountName)
+		}
+	}
+	return nil
+}
+
+// syncVPCs 分页拉取区域内的 VPC 并保存，返回同步条数
+func syncVPCs(client *vpc.Client, accountName string, regionID string) (int, error) {
+	pageSize := 50  // 每页返回的条数（最大 50）
+	pageNumber := 1 // 从第一页开始
+	totalCount := 0 // 总条数
+	var records []database.VPCRecord
+	for {
+		request := vpc.CreateDescribeVpcsRequest()
+		request.PageSize = requests.NewInteger(pageSize)     // 设置每页最大条数
+		request.PageNumber = requests.NewInteger(pageNumber) // 设置当前页数
+
+		response, err := client.DescribeVpcs(request)
+		if err != nil {
+			return 0, fmt.Errorf("VPC API 调用失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+		}
+
+		// 获取总数
+		if totalCount == 0 {
+			totalCount = response.TotalCount
+			logger.Log.Infof("数据查询完成, 区域=%s, 资源=VPC, 账户=%s, 总数=%d 条", regionID, accountName, totalCount)
+		}
+
+		for _, v := range response.Vpcs.Vpc {
+			records = append(records, database.VPCRecord{
+				VPCID:               v.VpcId,
+				CloudName:           accountName,
+				VPCName:             v.VpcName,
+				RegionID:            v.RegionId,
+				CidrBlock:           v.CidrBlock,
+				SecondaryCidrBlocks: strings.Join(v.SecondaryCidrBlocks.SecondaryCidrBlock, ","),
+				Ipv6CidrBlock:       v.Ipv6CidrBlock,
+				Status:              v.Status,
+				IsDefault:           v.IsDefault,
+				Description:         v.Description,
+			})
+		}
+		// 如果返回的数据条数小于 pageSize，说明已经拉取到最后一页，退出循环
+		if len(response.Vpcs.Vpc) < pageSize {
+			break
+		}
+		pageNumber++
+	}
+
+	if err := database.SaveVPCRecords(accountName, regionID, records); err != nil {
+		return 0, fmt.Errorf("保存 VPC 数据失败 (账户=%s): %w", accountName, err)
+	}
+	return len(records), nil
+}
+
+// syncVSwitches 分页拉取区域内的交换机并保存
+func syncVSwitches(client *vpc.Client, accountName string, regionID string) error {
+	pageSize := 50
+	pageNumber := 1
+	var records []database.VSwitchRecord
+	for {
+		request := vpc.CreateDescribeVSwitchesRequest()
+		request.PageSize = requests.NewInteger(pageSize)
+		request.PageNumber = requests.NewInteger(pageNumber)
+
+		response, err := client.DescribeVSwitches(request)
+		if err != nil {
+			return fmt.Errorf("交换机 API 调用失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+		}
+
+		for _, vsw := range response.VSwitches.VSwitch {
+			records = append(records, database.VSwitchRecord{
+				VSwitchID:        vsw.VSwitchId,
+				CloudName:        accountName,
+				VSwitchName:      vsw.VSwitchName,
+				VPCID:            vsw.VpcId,
+				RegionID:         regionID,
+				ZoneID:           vsw.ZoneId,
+				CidrBlock:        vsw.CidrBlock,
+				AvailableIPCount: vsw.AvailableIpAddressCount,
+				Status:           vsw.Status,
+				Description:      vsw.Description,
+			})
+		}
+		if len(response.VSwitches.VSwitch) < pageSize {
+			break
+		}
+		pageNumber++
+	}
+
+	if err := database.SaveVSwitchRecords(accountName, regionID, records); err != nil {
+		return fmt.Errorf("保存交换机数据失败 (账户=%s): %w", accountName, err)
+	}
+	logger.Log.Infof("数据同步完成, 区域=%s, 资源=交换机, 账户=%s, 同步=%d 条", regionID, accountName, len(records))
+	return nil
+}
+
+// syncRouteTables 分页拉取区域内的路由表，并逐个拉取路由条目
+func syncRouteTables(client *vpc.Client, accountName string, regionID string) error {
+	pageSize := 50
+	pageNumber := 1
+	var records []database.RouteTableRecord
+	for {
+		request := vpc.CreateDescribeRouteTableListRequest()
+		request.PageSize = requests.NewInteger(pageSize)
+		request.PageNumber = requests.NewInteger(pageNumber)
+
+		response, err := client.DescribeRouteTableList(request)
+		if err != nil {
+			return fmt.Errorf("路由表 API 调用失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+		}
+
+		for _, rt := range response.RouterTableList.RouterTableListType {
+			records = append(records, database.RouteTableRecord{
+				RouteTableID:   rt.RouteTableId,
+				CloudName:      accountName,
+				RouteTableName: rt.RouteTableName,
+				VPCID:          rt.VpcId,
+				RegionID:       regionID,
+				RouteTableType: rt.RouteTableType,
+				VSwitchIDs:     strings.Join(rt.VSwitchIds.VSwitchId, ","),
+				Description:    rt.Description,
+			})
+		}
+		if len(response.RouterTableList.RouterTableListType) < pageSize {
+			break
+		}
+		pageNumber++
+	}
+
+	if err := database.SaveRouteTableRecords(accountName, regionID, records); err != nil {
+		return fmt.Errorf("保存路由表数据失败 (账户=%s): %w", accountName, err)
+	}
+
+	// 路由条目使用 NextToken 分页
+	for _, rt := range records {
+		var entries []database.RouteEntryRecord
+		nextToken := ""
+		for {
+			request := vpc.CreateDescribeRouteEntryListRequest()
+			request.RouteTableId = rt.RouteTableID
+			request.MaxResult = requests.NewInteger(100)
+			request.NextToken = nextToken
+
+			response, err := client.DescribeRouteEntryList(request)
+			if err != nil {
+				return fmt.Errorf("获取路由条目失败 (RouteTableID=%s): %w", rt.RouteTableID, err)
+			}
+			for _, entry := range response.RouteEntrys.RouteEntry {
+				// 下一跳记录在 NextHops 中，ECMP 路由可能有多个下一跳
+				nextHopType := entry.NextHopType
+				var nextHopIDs []string
+				if entry.InstanceId != "" {
+					nextHopIDs = append(nextHopIDs, entry.InstanceId)
+				}
+				for _, hop := range entry.NextHops.NextHop {
+					if hop.NextHopId != "" && hop.NextHopId != entry.InstanceId {
+						nextHopIDs = append(nextHopIDs, hop.NextHopId)
+					}
+					if nextHopType == "" {
+						nextHopType = hop.NextHopType
+					}
+				}
+				entries = append(entries, database.RouteEntryRecord{
+					RouteTableID:         rt.RouteTableID,
+					RouteEntryID:         entry.RouteEntryId,
+					RouteEntryName:       entry.RouteEntryName,
+					DestinationCidrBlock: entry.DestinationCidrBlock,
+					NextHopType:          nextHopType,
+					NextHopID:            strings.Join(nextHopIDs, ","),
+					EntryType:            entry.Type,
+					Status:               entry.Status,
+					Description:          entry.Description,
+				})
+			}
+			if response.NextToken == "" {
+				break
+			}
+			nextToken = response.NextToken
+		}
+		if err := database.SaveRouteEntries(rt.RouteTableID, entries); err != nil {
+			return fmt.Errorf("保存路由条目失败 (账户=%s): %w", accountName, err)
+		}
+	}
+	logger.Log.Infof("数据同步完成, 区域=%s, 资源=路由表, 账户=%s, 同步=%d 条", regionID, accountName, len(records))
+	return nil
+}
+
+// syncNatGateways 分页拉取区域内的 NAT 网关，并逐个拉取 SNAT/DNAT 条目
+func syncNatGateways(client *vpc.Client, accountName string, regionID string) error {
+	pageSize := 50
+	pageNumber := 1
+	var records []database.NatGatewayRecord
+	snatTables := make(map[string][]string)    // NAT 网关ID -> SNAT 表ID
+	forwardTables := make(map[string][]string) // NAT 网关ID -> DNAT 表ID
+	for {
+		request := vpc.CreateDescribeNatGatewaysRequest()
+		request.PageSize = requests.NewInteger(pageSize)
+		request.PageNumber = requests.NewInteger(pageNumber)
+
+		response, err := client.DescribeNatGateways(request)
+		if err != nil {
+			return fmt.Errorf("NAT 网关 API 调用失败 (账户=%s, 区域=%s): %w", accountName, regionID, err)
+		}
+
+		for _, nat := range response.NatGateways.NatGateway {
+			var ipList []string
+			for _, ip := range nat.IpLists.IpList {
+				if ip.IpAddress != "" {
+					ipList = append(ipList, ip.IpAddress)
+				}
+			}
+			records = append(records, database.NatGatewayRecord{
+				NatGatewayID:   nat.NatGatewayId,
+				CloudName:      accountName,
+				NatGatewayName: nat.Name,
+				VPCID:          nat.VpcId,
+				RegionID:       regionID,
+				NatType:        nat.NatType,
+				NetworkType:    nat.NetworkType,
+				Spec:           nat.Spec,
+				Status:         nat.Status,
+				IPAddresses:    strings.Join(ipList, ","),
+				Description:    nat.Description,
+			})
+			snatTables[nat.NatGatewayId] = nat.SnatTableIds.SnatTableId
+			forwardTables[nat.NatGatewayId] = nat.ForwardTableIds.ForwardTableId
+		}
+		if len(response.NatGateways.NatGateway) < pageSize {
+			break
+		}
+		pageNumber++
+	}
+
+	if err := database.SaveNatGatewayRecords(accountName, regionID, records); err != nil {
+		return fmt.Errorf("保存 NAT 网关数据失败 (账户=%s): %w", accountName, err)
+	}
+
+	for _, nat := range records {
+		snatEntries, err := describeSnatEntries(client, snatTables[nat.NatGatewayID])
+		if err != nil {
+			return err
+		}
+		dnatEntries, err := describeDnatEntries(client, forwardTables[nat.NatGatewayID])
+		if err != nil {
+			return err
+		}
+		if err := database.SaveNatEntries(nat.NatGatewayID, snatEntries, dnatEntries); err != nil {
+			return fmt.Errorf("保存 NAT 条目失败 (账户=%s): %w", accountName, err)
+		}
+	}
+	logger.Log.Infof("数据同步完成, 区域=%s, 资源=NAT, 账户=%s, 同步=%d 条", regionID, accountName, len(records))
+	return nil
+}
+
+// describeSnatEntries 分页获取一组 SNAT 表中的全部条目
+func describeSnatEntries(client *vpc.Client, tableIDs []string) ([]database.SnatEntryRecord, error) {
+	var entries []database.SnatEntryRecord
+	for _, tableID := range tableIDs {
+		pageSize := 50
+		pageNumber := 1
+		for {
+			request := vpc.CreateDescribeSnatTableEntriesRequest()
+			request.SnatTableId = tableID
+			request.PageSize = requests.NewInteger(pageSize)
+			request.PageNumber = requests.NewInteger(pageNumber)
+
+			response, err := client.DescribeSnatTableEntries(request)
+			if err != nil {
+				return nil, fmt.Errorf("获取 SNAT 条目失败 (SnatTableID=%s): %w", tableID, err)
+			}
+			for _, entry := range response.SnatTableEntries.SnatTableEntry {
+				entries = append(entries, database.SnatEntryRecord{
+					SnatEntryID:     entry.SnatEntryId,
+					NatGatewayID:    entry.NatGatewayId,
+					SnatTableID:     entry.SnatTableId,
+					SnatEntryName:   entry.SnatEntryName,
+					SourceCIDR:      entry.SourceCIDR,
+					SourceVSwitchID: entry.SourceVSwitchId,
+					SnatIP:          entry.SnatIp,
+					Status:          entry.Status,
+				})
+			}
+			if len(response.SnatTableEntries.SnatTableEntry) < pageSize {
+				break
+			}
+			pageNumber++
+		}
+	}
+	return entries, nil
+}
+
+// describeDnatEntries 分页获取一组 DNAT 表中的全部条目
+func describeDnatEntries(client *vpc.Client, tableIDs []string) ([]database.DnatEntryRecord, error) {
+	var entries []database.DnatEntryRecord
+	for _, tableID := range tableIDs {
+		pageSize := 50
+		pageNumber := 1
+		for {
+			request := vpc.CreateDescribeForwardTableEntriesRequest()
+			request.ForwardTableId = tableID
+			request.PageSize = requests.NewInteger(pageSize)
+			request.PageNumber = requests.NewInteger(pageNumber)
+
+			response, err := client.DescribeForwardTableEntries(request)
+			if err != nil {
+				return nil, fmt.Errorf("获取 DNAT 条目失败 (ForwardTableID=%s): %w", tableID, err)
+			}
+			for _, entry := range response.ForwardTableEntries.ForwardTableEntry {
+				entries = append(entries, database.DnatEntryRecord{
+					ForwardEntryID:   entry.ForwardEntryId,
+					NatGatewayID:     entry.NatGatewayId,
+					ForwardTableID:   entry.ForwardTableId,
+					ForwardEntryName: entry.ForwardEntryName,
+					ExternalIP:       entry.ExternalIp,
+					ExternalPort:     entry.ExternalPort,
+					InternalIP:       entry.InternalIp,
+					InternalPort:     entry.InternalPort,
+					IPProtocol:       entry.IpProtocol,
+					Status:           entry.Status,
+				})
+			}
+			if len(response.ForwardTableEntries.ForwardTableEntry) < pageSize {
+				break
+			}
+			pageNumber++
+		}
+	}
+	return entries, nil
+}
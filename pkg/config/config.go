@@ -18,6 +18,26 @@ type Account struct {
 	SLBRegionIds     []string `yaml:"slb_region_ids" mapstructure:"slb_region_ids"`         // SLB 服务区域 ID
 	RedisRegionIds   []string `yaml:"redis_region_ids" mapstructure:"redis_region_ids"`     // Tair Redis 服务区域 ID
 	PolarDBRegionIds []string `yaml:"polardb_region_ids" mapstructure:"polardb_region_ids"` // PolarDB 服务区域 ID
+	VPCRegionIds     []string `yaml:"vpc_region_ids" mapstructure:"vpc_region_ids"`         // VPC 网络资源区域 ID
+	MongoDBRegionIds []string `yaml:"mongodb_region_ids" mapstructure:"mongodb_region_ids"` // MongoDB 服务区域 ID
+	MQRegionIds      []string `yaml:"mq_region_ids" mapstructure:"mq_region_ids"`           // 消息队列（Kafka/RocketMQ/RabbitMQ）区域 ID
+	ACKRegionIds     []string `yaml:"ack_region_ids" mapstructure:"ack_region_ids"`         // 容器服务 ACK 区域 ID
+	OSSRegionIds     []string `yaml:"oss_region_ids" mapstructure:"oss_region_ids"`         // OSS Bucket 所在区域 ID
+	DNSRegionIds     []string `yaml:"dns_region_ids" mapstructure:"dns_region_ids"`         // 云解析 DNS/PrivateZone 接入区域 ID（全局服务，配置一个即可）
+	CASRegionIds     []string `yaml:"cas_region_ids" mapstructure:"cas_region_ids"`         // 数字证书管理服务接入区域 ID（cn-hangzhou 或 ap-southeast-1）
+	RAMRegionIds     []string `yaml:"ram_region_ids" mapstructure:"ram_region_ids"`         // RAM 访问控制接入区域 ID（全局服务，配置一个即可）
+	CDNRegionIds     []string `yaml:"cdn_region_ids" mapstructure:"cdn_region_ids"`         // CDN/DCDN 接入区域 ID（全局服务，配置一个即可）
+	ESSRegionIds     []string `yaml:"ess_region_ids" mapstructure:"ess_region_ids"`         // 弹性伸缩 ESS 区域 ID
+	ECIRegionIds     []string `yaml:"eci_region_ids" mapstructure:"eci_region_ids"`         // 弹性容器实例 ECI 区域 ID
+	FCRegionIds      []string `yaml:"fc_region_ids" mapstructure:"fc_region_ids"`           // 函数计算 FC 区域 ID
+	SLSRegionIds     []string `yaml:"sls_region_ids" mapstructure:"sls_region_ids"`         // 日志服务 SLS 区域 ID
+	ACRRegionIds     []string `yaml:"acr_region_ids" mapstructure:"acr_region_ids"`         // 容器镜像服务 ACR 企业版区域 ID
+	BigDataRegionIds []string `yaml:"bigdata_region_ids" mapstructure:"bigdata_region_ids"` // Elasticsearch、ClickHouse、AnalyticDB、Lindorm 区域 ID
+	CENRegionIds     []string `yaml:"cen_region_ids" mapstructure:"cen_region_ids"`         // 云企业网 CEN 接入区域 ID（全局服务，配置一个即可）
+	BSSRegionIds     []string `yaml:"bss_region_ids" mapstructure:"bss_region_ids"`         // 费用中心 BSS 接入区域 ID（国内站 cn-hangzhou，国际站 ap-southeast-1）
+	BillingMonths    int      `yaml:"billing_months" mapstructure:"billing_months"`         // 拉取最近几个账期（含当月）的账单，默认 1
+	MetricRegionIds  []string `yaml:"metric_region_ids" mapstructure:"metric_region_ids"`   // 云监控指标采集区域 ID（可选，用于闲置资源检测）
+	MetricDays       int      `yaml:"metric_days" mapstructure:"metric_days"`               // 指标采集窗口（天），默认 7
 }
 
 // 数据库配置结构体
@@ -27,9 +47,10 @@ type DatabaseConfig struct {
 
 // 总配置结构体，包含所有配置项
 type Config struct {
-	AliyunAccounts []Account      `yaml:"aliyun_accounts" mapstructure:"aliyun_accounts"` // 阿里云账户列表
-	Database       DatabaseConfig `yaml:"database" mapstructure:"database"`               // 数据库配置
-	LogLevel       string         `yaml:"log_level" mapstructure:"log_level"`             // 日志级别
+	AliyunAccounts        []Account      `yaml:"aliyun_accounts" mapstructure:"aliyun_accounts"`                 // 阿里云账户列表
+	Database              DatabaseConfig `yaml:"database" mapstructure:"database"`                               // 数据库配置
+	LogLevel              string         `yaml:"log_level" mapstructure:"log_level"`                             // 日志级别
+	SnapshotRetentionDays int            `yaml:"snapshot_retention_days" mapstructure:"snapshot_retention_days"` // 资源快照保留天数，0 表示永久保留
 }
 
 // LoadConfig 加载配置文件，并支持环境变量覆盖配置。
@@ -0,0 +1,236 @@
+package database
+
+import (
+	"fmt"
+)
+
+// ACK 集群、节点池及节点表
+func initACKTables() error {
+	// 集群表
+	_, err := db.Exec(`CREATE TABLE IF NOT EXISTS ack_clusters (
+		cluster_id TEXT PRIMARY KEY,
+		cloud_name TEXT,
+		name TEXT,
+		cluster_type TEXT,
+		cluster_spec TEXT,
+		profile TEXT,
+		version TEXT,
+		state TEXT,
+		region_id TEXT,
+		zone_id TEXT,
+		vpc_id TEXT,
+		vswitch_id TEXT,
+		security_group_id TEXT,
+		api_server_endpoint TEXT,
+		intranet_api_server_endpoint TEXT,
+		create_time TEXT
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 ack_clusters 表失败: %w", err)
+	}
+	// 节点池表
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS ack_node_pools (
+		nodepool_id TEXT PRIMARY KEY,
+		cluster_id TEXT,
+		name TEXT,
+		type TEXT,
+		is_default INTEGER,
+		instance_types TEXT,
+		vswitch_ids TEXT,
+		scaling_group_id TEXT,
+		desired_size INTEGER,
+		total_nodes INTEGER,
+		healthy_nodes INTEGER,
+		state TEXT,
+		create_time TEXT
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 ack_node_pools 表失败: %w", err)
+	}
+	// 节点表：记录 ECS 实例所属的集群和节点池
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS ack_nodes (
+		instance_id TEXT PRIMARY KEY,
+		cluster_id TEXT,
+		nodepool_id TEXT,
+		node_name TEXT,
+		instance_role TEXT,
+		state TEXT
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 ack_nodes 表失败: %w", err)
+	}
+	_, err = db.Exec(`CREATE INDEX IF NOT EXISTS idx_ack_nodes_cluster ON ack_nodes (cluster_id);`)
+	if err != nil {
+		return fmt.Errorf("创建 ack_nodes 索引失败: %w", err)
+	}
+	return nil
+}
+
+// ACK 集群数据结构
+type ACKClusterRecord struct {
+	ClusterID                 string // 集群ID
+	CloudName                 string // 账户名称
+	Name                      string // 集群名称
+	ClusterType               string // 集群类型（Kubernetes/ManagedKubernetes/ExternalKubernetes）
+	ClusterSpec               string // 集群规格（ack.standard/ack.pro.small）
+	Profile                   string // 集群子类型（Default/Serverless/Edge 等）
+	Version                   string // Kubernetes 版本
+	State                     string // 状态
+	RegionID                  string // 区域ID
+	ZoneID                    string // 可用区
+	VPCID                     string // 专有网络ID
+	VSwitchID                 string // 交换机ID，多个以逗号分隔
+	SecurityGroupID           string // 安全组ID
+	APIServerEndpoint         string // API Server 公网地址
+	IntranetAPIServerEndpoint string // API Server 内网地址
+	CreateTime                string // 创建时间
+}
+
+// ACK 节点池数据结构
+type ACKNodePoolRecord struct {
+	NodePoolID     string // 节点池ID
+	ClusterID      string // 集群ID
+	Name           string // 节点池名称
+	Type           string // 节点池类型（ess/edge/lingjun 等）
+	IsDefault      bool   // 是否默认节点池
+	InstanceTypes  string // 实例规格，多个以逗号分隔
+	VSwitchIDs     string // 交换机ID，多个以逗号分隔
+	ScalingGroupID string // 弹性伸缩组ID
+	DesiredSize    int64  // 期望节点数
+	TotalNodes     int64  // 节点总数
+	HealthyNodes   int64  // 健康节点数
+	State          string // 状态
+	CreateTime     string // 创建时间
+}
+
+// ACK 节点数据结构
+type ACKNodeRecord struct {
+	InstanceID   string // ECS 实例ID
+	ClusterID    string // 集群ID
+	NodePoolID   string // 节点池ID
+	NodeName     string // Kubernetes 节点名称
+	InstanceRole string // 节点角色（Master/Worker）
+	State        string // 节点状态
+}
+
+// SaveACKClusterRecords 覆盖保存指定账户、区域下的全部 ACK 集群，已删除的集群及其节点池、节点会从表中删除
+func SaveACKClusterRecords(cloudName string, regionID string, records []ACKClusterRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM ack_clusters WHERE cloud_name = ? AND region_id = ?", cloudName, regionID); err != nil {
+		return fmt.Errorf("清理 ACK 集群记录失败 (账户=%s, 区域=%s): %w", cloudName, regionID, err)
+	}
+	for _, rec := range records {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO ack_clusters
+             (cluster_id, cloud_name, name, cluster_type, cluster_spec, profile, version, state, region_id, zone_id, vpc_id, vswitch_id,
+              security_group_id, api_server_endpoint, intranet_api_server_endpoint, create_time)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			rec.ClusterID, rec.CloudName, rec.Name, rec.ClusterType, rec.ClusterSpec, rec.Profile, rec.Version, rec.State, rec.RegionID,
+			rec.ZoneID, rec.VPCID, rec.VSwitchID, rec.SecurityGroupID, rec.APIServerEndpoint, rec.IntranetAPIServerEndpoint, rec.CreateTime,
+		)
+		if err != nil {
+			return fmt.Errorf("插入 ACK 集群记录失败 (ClusterID=%s): %w", rec.ClusterID, err)
+		}
+	}
+	// 已删除集群的节点池及节点不会再被同步覆盖，需一并清理
+	if err := deleteOrphans(tx, "SELECT cluster_id FROM ack_clusters", "cluster_id", "ack_node_pools", "ack_nodes"); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// 查询所有 ACK 集群记录
+func ListACKClusterRecords() ([]ACKClusterRecord, error) {
+	rows, err := db.Query(
+		`SELECT cluster_id, cloud_name, name, cluster_type, cluster_spec, profile, version, state, region_id, zone_id, vpc_id, vswitch_id,
+		        security_group_id, api_server_endpoint, intranet_api_server_endpoint, create_time
+		 FROM ack_clusters`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询 ACK 集群表失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []ACKClusterRecord
+	for rows.Next() {
+		var rec ACKClusterRecord
+		err := rows.Scan(&rec.ClusterID, &rec.CloudName, &rec.Name, &rec.ClusterType, &rec.ClusterSpec, &rec.Profile, &rec.Version,
+			&rec.State, &rec.RegionID, &rec.ZoneID, &rec.VPCID, &rec.VSwitchID, &rec.SecurityGroupID, &rec.APIServerEndpoint,
+			&rec.IntranetAPIServerEndpoint, &rec.CreateTime)
+		if err != nil {
+			return nil, fmt.Errorf("读取 ACK 集群行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
+
+// SaveACKClusterDetails 覆盖保存指定集群的节点池及节点
+func SaveACKClusterDetails(clusterID string, nodePools []ACKNodePoolRecord, nodes []ACKNodeRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM ack_node_pools WHERE cluster_id = ?", clusterID); err != nil {
+		return fmt.Errorf("清理 ACK 节点池失败 (ClusterID=%s): %w", clusterID, err)
+	}
+	if _, err := tx.Exec("DELETE FROM ack_nodes WHERE cluster_id = ?", clusterID); err != nil {
+		return fmt.Errorf("清理 ACK 节点失败 (ClusterID=%s): %w", clusterID, err)
+	}
+	for _, rec := range nodePools {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO ack_node_pools
+             (nodepool_id, cluster_id, name, type, is_default, instance_types, vswitch_ids, scaling_group_id, desired_size, total_nodes,
+              healthy_nodes, state, create_time)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			rec.NodePoolID, clusterID, rec.Name, rec.Type, rec.IsDefault, rec.InstanceTypes, rec.VSwitchIDs, rec.ScalingGroupID,
+			rec.DesiredSize, rec.TotalNodes, rec.HealthyNodes, rec.State, rec.CreateTime,
+		)
+		if err != nil {
+			return fmt.Errorf("插入 ACK 节点池记录失败 (NodePoolID=%s): %w", rec.NodePoolID, err)
+		}
+	}
+	for _, rec := range nodes {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO ack_nodes (instance_id, cluster_id, nodepool_id, node_name, instance_role, state)
+             VALUES (?, ?, ?, ?, ?, ?)`,
+			rec.InstanceID, clusterID, rec.NodePoolID, rec.NodeName, rec.InstanceRole, rec.State,
+		)
+		if err != nil {
+			return fmt.Errorf("插入 ACK 节点记录失败 (InstanceID=%s): %w", rec.InstanceID, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// 查询所有 ACK 节点池记录，按集群ID分组返回
+func ListACKNodePools() (map[string][]ACKNodePoolRecord, error) {
+	rows, err := db.Query(
+		`SELECT nodepool_id, cluster_id, name, type, is_default, instance_types, vswitch_ids, scaling_group_id, desired_size, total_nodes,
+		        healthy_nodes, state, create_time
+		 FROM ack_node_pools ORDER BY cluster_id, is_default DESC, name`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询 ACK 节点池表失败: %w", err)
+	}
+	defer rows.Close()
+
+	results := map[string][]ACKNodePoolRecord{}
+	for rows.Next() {
+		var rec ACKNodePoolRecord
+		err := rows.Scan(&rec.NodePoolID, &rec.ClusterID, &rec.Name, &rec.Type, &rec.IsDefault, &rec.InstanceTypes, &rec.VSwitchIDs,
+			&rec.ScalingGroupID, &rec.DesiredSize, &rec.TotalNodes, &rec.HealthyNodes, &rec.State, &rec.CreateTime)
+		if err != nil {
+			return nil, fmt.Errorf("读取 ACK 节点池行数据失败: %w", err)
+		}
+		results[rec.ClusterID] = append(results[rec.ClusterID], rec)
+	}
+	return results, nil
+}
@@ -0,0 +1,227 @@
+package database
+
+import (
+	"fmt"
+)
+
+// 容器镜像服务 ACR 企业版实例、命名空间及镜像仓库表
+func initACRTables() error {
+	// 实例表
+	_, err := db.Exec(`CREATE TABLE IF NOT EXISTS acr_instances (
+		instance_id TEXT PRIMARY KEY,
+		cloud_name TEXT,
+		region_id TEXT,
+		instance_name TEXT,
+		specification TEXT,
+		status TEXT,
+		create_time TEXT,
+		modify_time TEXT
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 acr_instances 表失败: %w", err)
+	}
+	// 命名空间表
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS acr_namespaces (
+		namespace_id TEXT PRIMARY KEY,
+		instance_id TEXT,
+		namespace_name TEXT,
+		status TEXT,
+		default_repo_type TEXT,
+		auto_create_repo INTEGER
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 acr_namespaces 表失败: %w", err)
+	}
+	// 镜像仓库表
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS acr_repositories (
+		repo_id TEXT PRIMARY KEY,
+		instance_id TEXT,
+		namespace_name TEXT,
+		repo_name TEXT,
+		repo_type TEXT,
+		build_type TEXT,
+		status TEXT,
+		summary TEXT,
+		create_time TEXT,
+		modify_time TEXT
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 acr_repositories 表失败: %w", err)
+	}
+	return nil
+}
+
+// ACR 企业版实例数据结构
+type ACRInstanceRecord struct {
+	InstanceID    string // 实例ID
+	CloudName     string // 账户名称
+	RegionID      string // 区域ID
+	InstanceName  string // 实例名称
+	Specification string // 实例规格（Basic/Standard/Advanced）
+	Status        string // 状态（RUNNING/STARTING/STOPPED 等）
+	CreateTime    string // 创建时间
+	ModifyTime    string // 修改时间
+}
+
+// ACR 命名空间数据结构
+type ACRNamespaceRecord struct {
+	NamespaceID     string // 命名空间ID
+	InstanceID      string // 所属实例ID
+	NamespaceName   string // 命名空间名称
+	Status          string // 状态
+	DefaultRepoType string // 自动创建仓库的默认类型（PUBLIC/PRIVATE）
+	AutoCreateRepo  bool   // 推送时是否自动创建仓库
+}
+
+// ACR 镜像仓库数据结构
+type ACRRepositoryRecord struct {
+	RepoID        string // 仓库ID
+	InstanceID    string // 所属实例ID
+	NamespaceName string // 所属命名空间
+	RepoName      string // 仓库名称
+	RepoType      string // 仓库类型（PUBLIC/PRIVATE）
+	BuildType     string // 构建方式（AUTO/MANUAL）
+	Status        string // 状态
+	Summary       string // 摘要
+	CreateTime    string // 创建时间
+	ModifyTime    string // 修改时间
+}
+
+// SaveACRInstanceRecords 覆盖保存指定账户、区域下的全部 ACR 实例，已删除实例的命名空间和镜像仓库会一并删除
+func SaveACRInstanceRecords(cloudName string, regionID string, records []ACRInstanceRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM acr_instances WHERE cloud_name = ? AND region_id = ?", cloudName, regionID); err != nil {
+		return fmt.Errorf("清理 ACR 实例记录失败 (账户=%s, 区域=%s): %w", cloudName, regionID, err)
+	}
+	for _, rec := range records {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO acr_instances
+             (instance_id, cloud_name, region_id, instance_name, specification, status, create_time, modify_time)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+			rec.InstanceID, rec.CloudName, rec.RegionID, rec.InstanceName, rec.Specification, rec.Status, rec.CreateTime, rec.ModifyTime,
+		)
+		if err != nil {
+			return fmt.Errorf("插入 ACR 实例记录失败 (InstanceID=%s): %w", rec.InstanceID, err)
+		}
+	}
+	// 已删除实例的命名空间和镜像仓库不会再被同步覆盖，需一并清理
+	if err := deleteOrphans(tx, "SELECT instance_id FROM acr_instances", "instance_id", "acr_namespaces", "acr_repositories"); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// 查询所有 ACR 实例记录
+func ListACRInstanceRecords() ([]ACRInstanceRecord, error) {
+	rows, err := db.Query(
+		`SELECT instance_id, cloud_name, region_id, instance_name, specification, status, create_time, modify_time
+		 FROM acr_instances`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询 ACR 实例表失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []ACRInstanceRecord
+	for rows.Next() {
+		var rec ACRInstanceRecord
+		err := rows.Scan(&rec.InstanceID, &rec.CloudName, &rec.RegionID, &rec.InstanceName, &rec.Specification, &rec.Status,
+			&rec.CreateTime, &rec.ModifyTime)
+		if err != nil {
+			return nil, fmt.Errorf("读取 ACR 实例行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
+
+// SaveACRInstanceDetails 覆盖保存指定实例下的命名空间及镜像仓库
+func SaveACRInstanceDetails(instanceID string, namespaces []ACRNamespaceRecord, repositories []ACRRepositoryRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, table := range []string{"acr_namespaces", "acr_repositories"} {
+		if _, err := tx.Exec("DELETE FROM "+table+" WHERE instance_id = ?", instanceID); err != nil {
+			return fmt.Errorf("清理 %s 失败 (InstanceID=%s): %w", table, instanceID, err)
+		}
+	}
+	for _, rec := range namespaces {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO acr_namespaces
+             (namespace_id, instance_id, namespace_name, status, default_repo_type, auto_create_repo)
+             VALUES (?, ?, ?, ?, ?, ?)`,
+			rec.NamespaceID, instanceID, rec.NamespaceName, rec.Status, rec.DefaultRepoType, rec.AutoCreateRepo,
+		)
+		if err != nil {
+			return fmt.Errorf("插入 ACR 命名空间记录失败 (NamespaceID=%s): %w", rec.NamespaceID, err)
+		}
+	}
+	for _, rec := range repositories {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO acr_repositories
+             (repo_id, instance_id, namespace_name, repo_name, repo_type, build_type, status, summary, create_time, modify_time)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			rec.RepoID, instanceID, rec.NamespaceName, rec.RepoName, rec.RepoType, rec.BuildType, rec.Status, rec.Summary,
+			rec.CreateTime, rec.ModifyTime,
+		)
+		if err != nil {
+			return fmt.Errorf("插入 ACR 镜像仓库记录失败 (RepoID=%s): %w", rec.RepoID, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// 查询所有 ACR 命名空间，按实例ID分组返回
+func ListACRNamespaces() (map[string][]ACRNamespaceRecord, error) {
+	rows, err := db.Query(
+		`SELECT namespace_id, instance_id, namespace_name, status, default_repo_type, auto_create_repo
+		 FROM acr_namespaces ORDER BY namespace_name`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询 ACR 命名空间表失败: %w", err)
+	}
+	defer rows.Close()
+
+	results := map[string][]ACRNamespaceRecord{}
+	for rows.Next() {
+		var rec ACRNamespaceRecord
+		err := rows.Scan(&rec.NamespaceID, &rec.InstanceID, &rec.NamespaceName, &rec.Status, &rec.DefaultRepoType, &rec.AutoCreateRepo)
+		if err != nil {
+			return nil, fmt.Errorf("读取 ACR 命名空间行数据失败: %w", err)
+		}
+		results[rec.InstanceID] = append(results[rec.InstanceID], rec)
+	}
+	return results, nil
+}
+
+// 查询所有 ACR 镜像仓库记录
+func ListACRRepositoryRecords() ([]ACRRepositoryRecord, error) {
+	rows, err := db.Query(
+		`SELECT repo_id, instance_id, namespace_name, repo_name, repo_type, build_type, status, summary, create_time, modify_time
+		 FROM acr_repositories ORDER BY namespace_name, repo_name`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询 ACR 镜像仓库表失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []ACRRepositoryRecord
+	for rows.Next() {
+		var rec ACRRepositoryRecord
+		err := rows.Scan(&rec.RepoID, &rec.InstanceID, &rec.NamespaceName, &rec.RepoName, &rec.RepoType, &rec.BuildType, &rec.Status,
+			&rec.Summary, &rec.CreateTime, &rec.ModifyTime)
+		if err != nil {
+			return nil, fmt.Errorf("读取 ACR 镜像仓库行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
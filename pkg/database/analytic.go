@@ -0,0 +1,113 @@
+package database
+
+import (
+	"fmt"
+)
+
+// 分析型数据库实例表（Elasticsearch、ClickHouse、AnalyticDB MySQL/PostgreSQL、Lindorm 共用）
+func initAnalyticTables() error {
+	_, err := db.Exec(`CREATE TABLE IF NOT EXISTS analytic_instances (
+		instance_id TEXT PRIMARY KEY,
+		product TEXT,
+		cloud_name TEXT,
+		description TEXT,
+		engine TEXT,
+		engine_version TEXT,
+		instance_class TEXT,
+		node_count INTEGER,
+		storage_size INTEGER,
+		status TEXT,
+		region_id TEXT,
+		zone_id TEXT,
+		vpc_id TEXT,
+		vswitch_id TEXT,
+		connection_string TEXT,
+		create_time TEXT
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 analytic_instances 表失败: %w", err)
+	}
+	return nil
+}
+
+// 分析型数据库产品
+const (
+	AnalyticProductElasticsearch = "elasticsearch" // 检索分析服务 Elasticsearch
+	AnalyticProductClickHouse    = "clickhouse"    // 云数据库 ClickHouse
+	AnalyticProductADBMySQL      = "adb_mysql"     // 云原生数据仓库 AnalyticDB MySQL 版
+	AnalyticProductADBPostgreSQL = "adb_pg"        // 云原生数据仓库 AnalyticDB PostgreSQL 版
+	AnalyticProductLindorm       = "lindorm"       // 云原生多模数据库 Lindorm
+)
+
+// 分析型数据库实例数据结构，字段与 RDSRecord 保持一致便于统一检索
+type AnalyticInstanceRecord struct {
+	InstanceID       string // 实例/集群ID
+	Product          string // 产品（elasticsearch/clickhouse/adb_mysql/adb_pg/lindorm）
+	CloudName        string // 账户名称
+	Description      string // 实例名称或描述
+	Engine           string // 引擎（Elasticsearch/ClickHouse/MySQL/PostgreSQL/Lindorm 引擎列表）
+	EngineVersion    string // 引擎版本
+	InstanceClass    string // 节点规格
+	NodeCount        int64  // 节点数
+	StorageSize      int64  // 存储空间合计（GB），按节点返回存储的产品已乘以节点数
+	Status           string // 状态
+	RegionID         string // 区域ID
+	ZoneID           string // 可用区
+	VPCID            string // 专有网络ID
+	VSwitchID        string // 交换机ID
+	ConnectionString string // 连接地址，多个以逗号分隔
+	CreateTime       string // 创建时间
+}
+
+// SaveAnalyticInstanceRecords 覆盖保存指定账户、区域下某一产品的全部实例，已释放的实例会从表中删除
+func SaveAnalyticInstanceRecords(cloudName string, regionID string, product string, records []AnalyticInstanceRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM analytic_instances WHERE cloud_name = ? AND region_id = ? AND product = ?", cloudName, regionID, product); err != nil {
+		return fmt.Errorf("清理分析型数据库记录失败 (账户=%s, 区域=%s, 产品=%s): %w", cloudName, regionID, product, err)
+	}
+	for _, rec := range records {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO analytic_instances
+             (instance_id, product, cloud_name, description, engine, engine_version, instance_class, node_count, storage_size, status,
+              region_id, zone_id, vpc_id, vswitch_id, connection_string, create_time)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			rec.InstanceID, rec.Product, rec.CloudName, rec.Description, rec.Engine, rec.EngineVersion, rec.InstanceClass, rec.NodeCount,
+			rec.StorageSize, rec.Status, rec.RegionID, rec.ZoneID, rec.VPCID, rec.VSwitchID, rec.ConnectionString, rec.CreateTime,
+		)
+		if err != nil {
+			return fmt.Errorf("插入分析型数据库记录失败 (InstanceID=%s): %w", rec.InstanceID, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// 查询所有分析型数据库实例记录
+func ListAnalyticInstanceRecords() ([]AnalyticInstanceRecord, error) {
+	rows, err := db.Query(
+		`SELECT instance_id, product, cloud_name, description, engine, engine_version, instance_class, node_count, storage_size, status,
+		        region_id, zone_id, vpc_id, vswitch_id, connection_string, create_time
+		 FROM analytic_instances`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询分析型数据库表失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []AnalyticInstanceRecord
+	for rows.Next() {
+		var rec AnalyticInstanceRecord
+		err := rows.Scan(&rec.InstanceID, &rec.Product, &rec.CloudName, &rec.Description, &rec.Engine, &rec.EngineVersion, &rec.InstanceClass,
+			&rec.NodeCount, &rec.StorageSize, &rec.Status, &rec.RegionID, &rec.ZoneID, &rec.VPCID, &rec.VSwitchID, &rec.ConnectionString,
+			&rec.CreateTime)
+		if err != nil {
+			return nil, fmt.Errorf("读取分析型数据库行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
@@ -0,0 +1,178 @@
+package database
+
+import (
+	"fmt"
+)
+
+// CDN/DCDN 加速域名及源站表
+func initCDNTables() error {
+	// 加速域名表
+	_, err := db.Exec(`CREATE TABLE IF NOT EXISTS cdn_domains (
+		domain_name TEXT PRIMARY KEY,
+		product TEXT,
+		cloud_name TEXT,
+		cname TEXT,
+		cdn_type TEXT,
+		status TEXT,
+		coverage TEXT,
+		ssl_protocol TEXT,
+		cert_id TEXT,
+		cert_name TEXT,
+		cert_issuer TEXT,
+		cert_expire_time TEXT,
+		description TEXT,
+		create_time TEXT
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 cdn_domains 表失败: %w", err)
+	}
+	// 源站表
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS cdn_origins (
+		domain_name TEXT,
+		origin_type TEXT,
+		content TEXT,
+		port INTEGER,
+		priority TEXT,
+		weight TEXT,
+		PRIMARY KEY (domain_name, content, port)
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 cdn_origins 表失败: %w", err)
+	}
+	return nil
+}
+
+// 加速产品类型
+const (
+	CDNProductCDN  = "cdn"  // CDN
+	CDNProductDCDN = "dcdn" // 全站加速 DCDN
+)
+
+// CDN/DCDN 加速域名数据结构
+type CDNDomainRecord struct {
+	DomainName     string // 加速域名
+	Product        string // 加速产品（cdn/dcdn）
+	CloudName      string // 账户名称
+	CNAME          string // 分配的 CNAME
+	CDNType        string // 业务类型（web/download/video 等）
+	Status         string // 状态（online/offline/configuring 等）
+	Coverage       string // 加速区域
+	SSLProtocol    string // 是否开启 HTTPS（on/off）
+	CertID         string // HTTPS 证书ID
+	CertName       string // HTTPS 证书名称
+	CertIssuer     string // HTTPS 证书颁发机构
+	CertExpireTime string // HTTPS 证书过期时间
+	Description    string // 描述
+	CreateTime     string // 创建时间
+}
+
+// CDN/DCDN 源站数据结构
+type CDNOriginRecord struct {
+	DomainName string // 加速域名
+	OriginType string // 源站类型（ipaddr/domain/oss/fc_domain 等）
+	Content    string // 源站地址
+	Port       int64  // 回源端口
+	Priority   string // 优先级（20 主/30 备）
+	Weight     string // 权重
+}
+
+// SaveCDNDomainRecords 覆盖保存指定账户、产品下的全部加速域名，已删除域名的源站会一并删除
+func SaveCDNDomainRecords(cloudName string, product string, records []CDNDomainRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM cdn_domains WHERE cloud_name = ? AND product = ?", cloudName, product); err != nil {
+		return fmt.Errorf("清理 CDN 域名记录失败 (账户=%s, 产品=%s): %w", cloudName, product, err)
+	}
+	for _, rec := range records {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO cdn_domains
+             (domain_name, product, cloud_name, cname, cdn_type, status, coverage, ssl_protocol, cert_id, cert_name, cert_issuer,
+              cert_expire_time, description, create_time)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			rec.DomainName, rec.Product, rec.CloudName, rec.CNAME, rec.CDNType, rec.Status, rec.Coverage, rec.SSLProtocol, rec.CertID,
+			rec.CertName, rec.CertIssuer, rec.CertExpireTime, rec.Description, rec.CreateTime,
+		)
+		if err != nil {
+			return fmt.Errorf("插入 CDN 域名记录失败 (DomainName=%s): %w", rec.DomainName, err)
+		}
+	}
+	// 已删除域名的源站不会再被同步覆盖，需一并清理
+	if err := deleteOrphans(tx, "SELECT domain_name FROM cdn_domains", "domain_name", "cdn_origins"); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// 查询所有 CDN/DCDN 加速域名记录
+func ListCDNDomainRecords() ([]CDNDomainRecord, error) {
+	rows, err := db.Query(
+		`SELECT domain_name, product, cloud_name, cname, cdn_type, status, coverage, ssl_protocol, cert_id, cert_name, cert_issuer,
+		        cert_expire_time, description, create_time
+		 FROM cdn_domains ORDER BY product, domain_name`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询 CDN 域名表失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []CDNDomainRecord
+	for rows.Next() {
+		var rec CDNDomainRecord
+		err := rows.Scan(&rec.DomainName, &rec.Product, &rec.CloudName, &rec.CNAME, &rec.CDNType, &rec.Status, &rec.Coverage, &rec.SSLProtocol,
+			&rec.CertID, &rec.CertName, &rec.CertIssuer, &rec.CertExpireTime, &rec.Description, &rec.CreateTime)
+		if err != nil {
+			return nil, fmt.Errorf("读取 CDN 域名行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
+
+// SaveCDNOrigins 覆盖保存指定加速域名的源站
+func SaveCDNOrigins(domainName string, records []CDNOriginRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM cdn_origins WHERE domain_name = ?", domainName); err != nil {
+		return fmt.Errorf("清理 CDN 源站失败 (DomainName=%s): %w", domainName, err)
+	}
+	for _, rec := range records {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO cdn_origins (domain_name, origin_type, content, port, priority, weight)
+             VALUES (?, ?, ?, ?, ?, ?)`,
+			domainName, rec.OriginType, rec.Content, rec.Port, rec.Priority, rec.Weight,
+		)
+		if err != nil {
+			return fmt.Errorf("插入 CDN 源站记录失败 (DomainName=%s, Content=%s): %w", domainName, rec.Content, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// 查询所有 CDN 源站，按加速域名分组返回
+func ListCDNOrigins() (map[string][]CDNOriginRecord, error) {
+	rows, err := db.Query(
+		`SELECT domain_name, origin_type, content, port, priority, weight FROM cdn_origins ORDER BY domain_name, priority, content`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询 CDN 源站表失败: %w", err)
+	}
+	defer rows.Close()
+
+	results := map[string][]CDNOriginRecord{}
+	for rows.Next() {
+		var rec CDNOriginRecord
+		if err := rows.Scan(&rec.DomainName, &rec.OriginType, &rec.Content, &rec.Port, &rec.Priority, &rec.Weight); err != nil {
+			return nil, fmt.Errorf("读取 CDN 源站行数据失败: %w", err)
+		}
+		results[rec.DomainName] = append(results[rec.DomainName], rec)
+	}
+	return results, nil
+}
@@ -0,0 +1,145 @@
+package database
+
+import (
+	"fmt"
+)
+
+// SSL 证书表（数字证书管理服务及 CLB 上传的服务器证书）
+func initCertificateTables() error {
+	_, err := db.Exec(`CREATE TABLE IF NOT EXISTS certificates (
+		cert_id TEXT,
+		source TEXT,
+		cloud_name TEXT,
+		name TEXT,
+		common_name TEXT,
+		sans TEXT,
+		issuer TEXT,
+		not_before TEXT,
+		not_after TEXT,
+		region_id TEXT,
+		cas_cert_id TEXT,
+		fingerprint TEXT,
+		status TEXT,
+		PRIMARY KEY (source, cert_id)
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 certificates 表失败: %w", err)
+	}
+	return nil
+}
+
+// 证书来源
+const (
+	CertSourceCAS = "cas" // 数字证书管理服务
+	CertSourceSLB = "slb" // CLB 服务器证书
+	CertSourceCDN = "cdn" // 仅在 CDN/DCDN 加速域名上发现、未在 CAS 中同步到的证书
+)
+
+// SSL 证书数据结构
+type CertificateRecord struct {
+	CertID      string // 证书ID（CAS 为数字ID，CLB 为 ServerCertificateId）
+	Source      string // 证书来源（cas/slb）
+	CloudName   string // 账户名称
+	Name        string // 证书名称
+	CommonName  string // 主域名
+	SANs        string // 全部绑定域名，多个以逗号分隔
+	Issuer      string // 颁发机构
+	NotBefore   string // 生效时间
+	NotAfter    string // 过期时间
+	RegionID    string // 区域ID（CLB 证书为所在区域，CAS 证书为接入区域）
+	CASCertID   string // CLB 证书引用的 CAS 证书ID
+	Fingerprint string // 指纹
+	Status      string // 状态
+}
+
+// 引用证书的负载均衡监听
+type CertificateDeploymentRecord struct {
+	CertificateID string // 监听上的证书ID，多个以逗号分隔
+	LBType        string // 负载均衡类型（clb/alb/nlb）
+	LBID          string // 负载均衡实例ID
+	LBName        string // 负载均衡名称
+	CloudName     string // 账户名称
+	ListenerID    string // 监听ID
+	ListenerPort  int64  // 监听端口
+	Protocol      string // 监听协议
+}
+
+// SaveCertificateRecords 覆盖保存指定账户、来源、区域下的全部证书，已删除的证书会从表中删除
+func SaveCertificateRecords(cloudName string, source string, regionID string, records []CertificateRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM certificates WHERE cloud_name = ? AND source = ? AND region_id = ?", cloudName, source, regionID); err != nil {
+		return fmt.Errorf("清理证书记录失败 (账户=%s, 来源=%s, 区域=%s): %w", cloudName, source, regionID, err)
+	}
+	for _, rec := range records {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO certificates
+             (cert_id, source, cloud_name, name, common_name, sans, issuer, not_before, not_after, region_id, cas_cert_id, fingerprint, status)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			rec.CertID, rec.Source, rec.CloudName, rec.Name, rec.CommonName, rec.SANs, rec.Issuer, rec.NotBefore, rec.NotAfter,
+			rec.RegionID, rec.CASCertID, rec.Fingerprint, rec.Status,
+		)
+		if err != nil {
+			return fmt.Errorf("插入证书记录失败 (CertID=%s): %w", rec.CertID, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// 查询所有证书记录
+func ListCertificateRecords() ([]CertificateRecord, error) {
+	rows, err := db.Query(
+		`SELECT cert_id, source, cloud_name, name, common_name, sans, issuer, not_before, not_after, region_id, cas_cert_id, fingerprint, status
+		 FROM certificates`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询证书表失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []CertificateRecord
+	for rows.Next() {
+		var rec CertificateRecord
+		err := rows.Scan(&rec.CertID, &rec.Source, &rec.CloudName, &rec.Name, &rec.CommonName, &rec.SANs, &rec.Issuer, &rec.NotBefore,
+			&rec.NotAfter, &rec.RegionID, &rec.CASCertID, &rec.Fingerprint, &rec.Status)
+		if err != nil {
+			return nil, fmt.Errorf("读取证书行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
+
+// 查询所有配置了证书的负载均衡监听
+func ListCertificateDeployments() ([]CertificateDeploymentRecord, error) {
+	rows, err := db.Query(
+		`SELECT l.certificate_id, l.lb_type, l.lb_id,
+		        COALESCE(s.lb_name, a.lb_name, ''), COALESCE(s.cloud_name, a.cloud_name, ''),
+		        l.listener_id, l.listener_port, l.protocol
+		 FROM lb_listeners l
+		 LEFT JOIN slb s ON s.lb_id = l.lb_id
+		 LEFT JOIN load_balancers a ON a.lb_id = l.lb_id
+		 WHERE IFNULL(l.certificate_id, '') != ''
+		 ORDER BY l.lb_id, l.listener_port`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询证书部署失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []CertificateDeploymentRecord
+	for rows.Next() {
+		var rec CertificateDeploymentRecord
+		err := rows.Scan(&rec.CertificateID, &rec.LBType, &rec.LBID, &rec.LBName, &rec.CloudName, &rec.ListenerID, &rec.ListenerPort,
+			&rec.Protocol)
+		if err != nil {
+			return nil, fmt.Errorf("读取证书部署行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
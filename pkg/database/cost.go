@@ -0,0 +1,121 @@
+package database
+
+import (
+	"fmt"
+)
+
+// 实例账单费用表：按账期汇总每个实例的费用
+func initCostTables() error {
+	_, err := db.Exec(`CREATE TABLE IF NOT EXISTS costs (
+		cloud_name TEXT,
+		billing_cycle TEXT,
+		instance_id TEXT,
+		product_code TEXT,
+		product_name TEXT,
+		product_type TEXT,
+		subscription_type TEXT,
+		region TEXT,
+		resource_group TEXT,
+		tag TEXT,
+		pretax_gross_amount REAL,
+		pretax_amount REAL,
+		payment_amount REAL,
+		currency TEXT,
+		PRIMARY KEY (cloud_name, billing_cycle, instance_id, product_code)
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 costs 表失败: %w", err)
+	}
+	return nil
+}
+
+// 实例账单费用数据结构
+type CostRecord struct {
+	CloudName         string  // 账户名称
+	BillingCycle      string  // 账期（YYYY-MM）
+	InstanceID        string  // 实例ID
+	ProductCode       string  // 产品代码（ecs/rds/slb/kvstore/polardb 等）
+	ProductName       string  // 产品名称
+	ProductType       string  // 产品类型
+	SubscriptionType  string  // 付费方式（Subscription/PayAsYouGo）
+	Region            string  // 地域
+	ResourceGroup     string  // 资源组
+	Tag               string  // 实例标签，格式为 "key:k1 value:v1; key:k2 value:v2"
+	PretaxGrossAmount float64 // 原价
+	PretaxAmount      float64 // 应付金额（优惠后）
+	PaymentAmount     float64 // 现金支付金额
+	Currency          string  // 币种
+}
+
+// SaveCostRecords 覆盖保存指定账户某个账期的实例费用，当月账单会随同步更新
+func SaveCostRecords(cloudName string, billingCycle string, records []CostRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM costs WHERE cloud_name = ? AND billing_cycle = ?", cloudName, billingCycle); err != nil {
+		return fmt.Errorf("清理账单费用失败 (账户=%s, 账期=%s): %w", cloudName, billingCycle, err)
+	}
+	for _, rec := range records {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO costs
+             (cloud_name, billing_cycle, instance_id, product_code, product_name, product_type, subscription_type, region, resource_group,
+              tag, pretax_gross_amount, pretax_amount, payment_amount, currency)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			cloudName, billingCycle, rec.InstanceID, rec.ProductCode, rec.ProductName, rec.ProductType, rec.SubscriptionType, rec.Region,
+			rec.ResourceGroup, rec.Tag, rec.PretaxGrossAmount, rec.PretaxAmount, rec.PaymentAmount, rec.Currency,
+		)
+		if err != nil {
+			return fmt.Errorf("插入账单费用记录失败 (InstanceID=%s): %w", rec.InstanceID, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// 查询指定账期的实例费用记录，按应付金额从高到低排序
+func ListCostRecords(billingCycle string) ([]CostRecord, error) {
+	rows, err := db.Query(
+		`SELECT cloud_name, billing_cycle, instance_id, product_code, product_name, product_type, subscription_type, region, resource_group,
+		        tag, pretax_gross_amount, pretax_amount, payment_amount, currency
+		 FROM costs WHERE billing_cycle = ? ORDER BY pretax_amount DESC`,
+		billingCycle,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询账单费用表失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []CostRecord
+	for rows.Next() {
+		var rec CostRecord
+		err := rows.Scan(&rec.CloudName, &rec.BillingCycle, &rec.InstanceID, &rec.ProductCode, &rec.ProductName, &rec.ProductType,
+			&rec.SubscriptionType, &rec.Region, &rec.ResourceGroup, &rec.Tag, &rec.PretaxGrossAmount, &rec.PretaxAmount, &rec.PaymentAmount,
+			&rec.Currency)
+		if err != nil {
+			return nil, fmt.Errorf("读取账单费用行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
+
+// 查询已同步的账期，按时间倒序
+func ListBillingCycles() ([]string, error) {
+	rows, err := db.Query(`SELECT DISTINCT billing_cycle FROM costs ORDER BY billing_cycle DESC`)
+	if err != nil {
+		return nil, fmt.Errorf("查询账单费用表失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []string
+	for rows.Next() {
+		var cycle string
+		if err := rows.Scan(&cycle); err != nil {
+			return nil, fmt.Errorf("读取账单费用行数据失败: %w", err)
+		}
+		results = append(results, cycle)
+	}
+	return results, nil
+}
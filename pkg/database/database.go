@@ -108,7 +108,171 @@ func Init(dbPath string) error {
 	if err != nil {
 		return fmt.Errorf("创建 polardb 表失败: %w", err)
 	}
+	// 旧版本数据库升级：补齐后续版本在已有表上新增的字段
+	for _, m := range columnMigrations {
+		if err := ensureColumn(m.table, m.column, m.definition); err != nil {
+			return err
+		}
+	}
+	// 安全组及规则相关表
+	if err := initSecurityGroupTables(); err != nil {
+		return err
+	}
+	// VPC、交换机、路由表及 NAT 网关相关表
+	if err := initNetworkTables(); err != nil {
+		return err
+	}
+	// 弹性公网 IP 表
+	if err := initEIPTables(); err != nil {
+		return err
+	}
+	// 负载均衡监听及后端服务器表
+	if err := initLoadBalancerTables(); err != nil {
+		return err
+	}
+	// RDS 数据库、账号及白名单表
+	if err := initRDSDetailTables(); err != nil {
+		return err
+	}
+	// PolarDB 节点及连接地址表
+	if err := initPolarDBDetailTables(); err != nil {
+		return err
+	}
+	// Tair Redis 连接地址表
+	if err := initRedisDetailTables(); err != nil {
+		return err
+	}
+	// MongoDB 实例及连接地址表
+	if err := initMongoDBTables(); err != nil {
+		return err
+	}
+	// 消息队列实例及 Topic/Group 表
+	if err := initMQTables(); err != nil {
+		return err
+	}
+	// ACK 集群、节点池及节点表
+	if err := initACKTables(); err != nil {
+		return err
+	}
+	// OSS Bucket 表
+	if err := initOSSTables(); err != nil {
+		return err
+	}
+	// 云解析 DNS 及 PrivateZone 表
+	if err := initDNSTables(); err != nil {
+		return err
+	}
+	// SSL 证书表
+	if err := initCertificateTables(); err != nil {
+		return err
+	}
+	// RAM 用户、角色及 AccessKey 表
+	if err := initRAMTables(); err != nil {
+		return err
+	}
+	// CDN/DCDN 加速域名及源站表
+	if err := initCDNTables(); err != nil {
+		return err
+	}
+	// 弹性伸缩及弹性容器实例表
+	if err := initESSTables(); err != nil {
+		return err
+	}
+	if err := initECITables(); err != nil {
+		return err
+	}
+	// 函数计算、日志服务及容器镜像服务表
+	if err := initFCTables(); err != nil {
+		return err
+	}
+	if err := initSLSTables(); err != nil {
+		return err
+	}
+	if err := initACRTables(); err != nil {
+		return err
+	}
+	// 分析型数据库实例表
+	if err := initAnalyticTables(); err != nil {
+		return err
+	}
+	// VPN 网关、云企业网及高速通道表
+	if err := initHybridNetworkTables(); err != nil {
+		return err
+	}
+	// 实例账单费用表
+	if err := initCostTables(); err != nil {
+		return err
+	}
+	// 云监控指标汇总表
+	if err := initMetricTables(); err != nil {
+		return err
+	}
+	// 资源统计历史及资源快照表
+	if err := initHistoryTables(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// 后续版本在已有资源表上新增的字段，Init 时自动补齐，兼容旧版本生成的数据库文件
+var columnMigrations = []struct {
+	table      string
+	column     string
+	definition string
+}{
+	{"ecs", "vpc_id", "TEXT"},
+	{"ecs", "vswitch_id", "TEXT"},
+	{"rds", "vpc_id", "TEXT"},
+	{"rds", "vswitch_id", "TEXT"},
+	{"slb", "vpc_id", "TEXT"},
+	{"slb", "vswitch_id", "TEXT"},
+	{"redis", "vpc_id", "TEXT"},
+	{"redis", "vswitch_id", "TEXT"},
+	{"polardb", "vpc_id", "TEXT"},
+	{"polardb", "vswitch_id", "TEXT"},
+	{"rds", "engine_version", "TEXT"},
+	{"rds", "instance_class", "TEXT"},
+	{"rds", "storage_size", "INTEGER"},
+	{"rds", "storage_type", "TEXT"},
+	{"rds", "zone_id", "TEXT"},
+	{"rds", "ha_mode", "TEXT"},
+	{"redis", "engine_version", "TEXT"},
+	{"redis", "architecture", "TEXT"},
+	{"redis", "shard_count", "INTEGER"},
+	{"redis", "zone_id", "TEXT"},
+	{"redis", "status", "TEXT"},
+}
+
+// 如果表中不存在指定字段，则通过 ALTER TABLE 添加
+func ensureColumn(table, column, definition string) error {
+	rows, err := db.Query(fmt.Sprintf("PRAGMA table_info(%s)", table))
+	if err != nil {
+		return fmt.Errorf("读取 %s 表结构失败: %w", table, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			cid       int
+			name      string
+			colType   string
+			notNull   int
+			dfltValue sql.NullString
+			pk        int
+		)
+		if err := rows.Scan(&cid, &name, &colType, &notNull, &dfltValue, &pk); err != nil {
+			return fmt.Errorf("读取 %s 表结构失败: %w", table, err)
+		}
+		if name == column {
+			return nil
+		}
+	}
+	rows.Close()
 
+	if _, err := db.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table, column, definition)); err != nil {
+		return fmt.Errorf("为 %s 表添加字段 %s 失败: %w", table, column, err)
+	}
 	return nil
 }
 
@@ -119,45 +283,87 @@ func Close() {
 	}
 }
 
+// deleteOrphans 删除子表中父记录已不存在的行，parentQuery 返回仍存在的父记录ID。
+// 仅适用于由父表的采集器在同一区域的同步中写入的子表，否则尚未同步的父记录会被误判为已删除。
+func deleteOrphans(tx *sql.Tx, parentQuery string, column string, tables ...string) error {
+	for _, table := range tables {
+		if _, err := tx.Exec(fmt.Sprintf("DELETE FROM %s WHERE %s NOT IN (%s)", table, column, parentQuery)); err != nil {
+			return fmt.Errorf("清理 %s 表残留记录失败: %w", table, err)
+		}
+	}
+	return nil
+}
+
 // ------ 以下是各类资源的 CRUD 操作封装 ------
 
 // ECSRecord 定义 ECS 记录的本地结构，用于数据库读写
 type ECSRecord struct {
-	InstanceID   string // 实例ID
-	CloudName    string // 账户名称
-	InstanceName string // 实例名称
-	Status       string // 实例状态
-	RegionID     string // 区域ID
-	OSName       string // 操作系统名称
-	InstanceType string // 实例规格
-	CPU          int64  // CPU核数
-	Memory       int64  // 内存大小
-	PublicIP     string // 公网IP地址(逗号分隔)
-	PrivateIP    string // 内网IP地址
+	InstanceID       string // 实例ID
+	CloudName        string // 账户名称
+	InstanceName     string // 实例名称
+	Status           string // 实例状态
+	RegionID         string // 区域ID
+	OSName           string // 操作系统名称
+	InstanceType     string // 实例规格
+	CPU              int64  // CPU核数
+	Memory           int64  // 内存大小
+	PublicIP         string // 公网IP地址(逗号分隔)
+	PrivateIP        string // 内网IP地址
+	VPCID            string // 专有网络ID
+	VSwitchID        string // 交换机ID
+	ClusterID        string // 所属 ACK 集群ID（由 ACK 节点同步关联，非容器节点为空）
+	ClusterName      string // 所属 ACK 集群名称
+	NodePoolID       string // 所属 ACK 节点池ID
+	NodePoolName     string // 所属 ACK 节点池名称
+	ScalingGroupID   string // 所属弹性伸缩组ID（由 ESS 同步关联，非伸缩实例为空）
+	ScalingGroupName string // 所属弹性伸缩组名称
 }
 
-// SaveECSRecords 将一组 ECS 实例记录保存到数据库
-func SaveECSRecords(records []ECSRecord) error {
+// SaveECSRecords 覆盖保存指定账户、区域下的全部 ECS 实例记录。
+// 已释放的实例（包括弹性伸缩缩容释放的实例）及其安全组关联会从表中删除。
+func SaveECSRecords(cloudName string, regionID string, records []ECSRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM ecs WHERE cloud_name = ? AND region_id = ?", cloudName, regionID); err != nil {
+		return fmt.Errorf("清理 ECS 记录失败 (账户=%s, 区域=%s): %w", cloudName, regionID, err)
+	}
 	for _, rec := range records {
-		_, err := db.Exec(
+		_, err := tx.Exec(
 			`INSERT OR REPLACE INTO ecs 
-             (instance_id, cloud_name, instance_name, status, region_id, os_name, instance_type, cpu, memory, public_ip, private_ip) 
-             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+             (instance_id, cloud_name, instance_name, status, region_id, os_name, instance_type, cpu, memory, public_ip, private_ip, vpc_id, vswitch_id) 
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
 			rec.InstanceID, rec.CloudName, rec.InstanceName, rec.Status, rec.RegionID, rec.OSName,
-			rec.InstanceType, rec.CPU, rec.Memory, rec.PublicIP, rec.PrivateIP,
+			rec.InstanceType, rec.CPU, rec.Memory, rec.PublicIP, rec.PrivateIP, rec.VPCID, rec.VSwitchID,
 		)
 		if err != nil {
 			// 返回封装了上下文的错误，包含出错的实例ID
 			return fmt.Errorf("插入 ECS 记录失败 (InstanceID=%s): %w", rec.InstanceID, err)
 		}
 	}
-	return nil
+	// 已释放实例的安全组关联不会再被同步覆盖，需一并清理
+	if err := deleteOrphans(tx, "SELECT instance_id FROM ecs", "instance_id", "ecs_security_groups"); err != nil {
+		return err
+	}
+	return tx.Commit()
 }
 
 // 查询所有 ECS 记录（用于 API 层示例）
 func ListECSRecords() ([]ECSRecord, error) {
 	rows, err := db.Query(
-		"SELECT instance_id, cloud_name, instance_name, status, region_id, os_name, instance_type, cpu, memory, public_ip, private_ip FROM ecs",
+		`SELECT e.instance_id, e.cloud_name, e.instance_name, e.status, e.region_id, e.os_name, e.instance_type, e.cpu, e.memory,
+		        e.public_ip, e.private_ip, IFNULL(e.vpc_id, ''), IFNULL(e.vswitch_id, ''),
+		        IFNULL(n.cluster_id, ''), IFNULL(c.name, ''), IFNULL(n.nodepool_id, ''), IFNULL(p.name, ''),
+		        IFNULL(i.scaling_group_id, ''), IFNULL(g.name, '')
+		 FROM ecs e
+		 LEFT JOIN ack_nodes n ON n.instance_id = e.instance_id
+		 LEFT JOIN ack_clusters c ON c.cluster_id = n.cluster_id
+		 LEFT JOIN ack_node_pools p ON p.nodepool_id = n.nodepool_id
+		 LEFT JOIN ess_instances i ON i.instance_id = e.instance_id
+		 LEFT JOIN ess_scaling_groups g ON g.scaling_group_id = i.scaling_group_id`,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("查询 ECS 表失败: %w", err)
@@ -169,7 +375,8 @@ func ListECSRecords() ([]ECSRecord, error) {
 		var rec ECSRecord
 		// 将查询结果的每一行扫描到 ECSRecord 结构体
 		err := rows.Scan(&rec.InstanceID, &rec.CloudName, &rec.InstanceName, &rec.Status, &rec.RegionID,
-			&rec.OSName, &rec.InstanceType, &rec.CPU, &rec.Memory, &rec.PublicIP, &rec.PrivateIP)
+			&rec.OSName, &rec.InstanceType, &rec.CPU, &rec.Memory, &rec.PublicIP, &rec.PrivateIP, &rec.VPCID, &rec.VSwitchID,
+			&rec.ClusterID, &rec.ClusterName, &rec.NodePoolID, &rec.NodePoolName, &rec.ScalingGroupID, &rec.ScalingGroupName)
 		if err != nil {
 			return nil, fmt.Errorf("读取 ECS 行数据失败: %w", err)
 		}
@@ -190,27 +397,53 @@ type RDSRecord struct {
 	Memory           int64
 	Description      string
 	ConnectionString string
+	VPCID            string
+	VSwitchID        string
+	EngineVersion    string // 引擎版本
+	InstanceClass    string // 实例规格
+	StorageSize      int64  // 存储空间（GB）
+	StorageType      string // 存储类型
+	ZoneID           string // 可用区
+	HAMode           string // 系列（Basic/HighAvailability/Finance 等）
 }
 
-func SaveRDSRecords(records []RDSRecord) error {
+// SaveRDSRecords 覆盖保存指定账户、区域下的全部 RDS 实例，已释放的实例及其数据库、账号、白名单会从表中删除
+func SaveRDSRecords(cloudName string, regionID string, records []RDSRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM rds WHERE cloud_name = ? AND region_id = ?", cloudName, regionID); err != nil {
+		return fmt.Errorf("清理 RDS 记录失败 (账户=%s, 区域=%s): %w", cloudName, regionID, err)
+	}
 	for _, rec := range records {
-		_, err := db.Exec(
+		_, err := tx.Exec(
 			`INSERT OR REPLACE INTO rds 
-             (instance_id, cloud_name, engine, region_id, status, memory, instance_description, connection_string)
-             VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+             (instance_id, cloud_name, engine, region_id, status, memory, instance_description, connection_string, vpc_id, vswitch_id,
+              engine_version, instance_class, storage_size, storage_type, zone_id, ha_mode)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
 			rec.InstanceID, rec.CloudName, rec.Engine, rec.RegionID, rec.Status, rec.Memory, rec.Description, rec.ConnectionString,
+			rec.VPCID, rec.VSwitchID, rec.EngineVersion, rec.InstanceClass, rec.StorageSize, rec.StorageType, rec.ZoneID, rec.HAMode,
 		)
 		if err != nil {
 			return fmt.Errorf("插入 RDS 记录失败 (InstanceID=%s): %w", rec.InstanceID, err)
 		}
 	}
-	return nil
+	// 已释放实例的数据库、账号、权限及白名单不会再被同步覆盖，需一并清理
+	if err := deleteOrphans(tx, "SELECT instance_id FROM rds", "instance_id", "rds_databases", "rds_accounts", "rds_account_privileges", "rds_whitelists"); err != nil {
+		return err
+	}
+	return tx.Commit()
 }
 
 // 查询所有 RDS 记录（用于 API 层示例）
 func ListRDSRecords() ([]RDSRecord, error) {
 	rows, err := db.Query(
-		"SELECT instance_id, cloud_name, engine, region_id, status, memory, instance_description, connection_string FROM rds",
+		`SELECT instance_id, cloud_name, engine, region_id, status, memory, instance_description, connection_string, IFNULL(vpc_id, ''), IFNULL(vswitch_id, ''),
+		        IFNULL(engine_version, ''), IFNULL(instance_class, ''), IFNULL(storage_size, 0), IFNULL(storage_type, ''), IFNULL(zone_id, ''), IFNULL(ha_mode, '')
+		 FROM rds`,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("查询 RDS 表失败: %w", err)
@@ -222,7 +455,8 @@ func ListRDSRecords() ([]RDSRecord, error) {
 		var rec RDSRecord
 		// 将查询结果的每一行扫描到 RDSRecord 结构体
 		err := rows.Scan(&rec.InstanceID, &rec.CloudName, &rec.Engine, &rec.RegionID,
-			&rec.Status, &rec.Memory, &rec.Description, &rec.ConnectionString)
+			&rec.Status, &rec.Memory, &rec.Description, &rec.ConnectionString, &rec.VPCID, &rec.VSwitchID,
+			&rec.EngineVersion, &rec.InstanceClass, &rec.StorageSize, &rec.StorageType, &rec.ZoneID, &rec.HAMode)
 		if err != nil {
 			return nil, fmt.Errorf("读取 RDS 行数据失败: %w", err)
 		}
@@ -241,27 +475,43 @@ type SLBRecord struct {
 	NetworkType      string
 	RegionID         string
 	Status           string
+	VPCID            string
+	VSwitchID        string
 }
 
-func SaveSLBRecords(records []SLBRecord) error {
+// SaveSLBRecords 覆盖保存指定账户、区域下的全部 SLB 实例，已释放的实例及其监听、服务器组会从表中删除
+func SaveSLBRecords(cloudName string, regionID string, records []SLBRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM slb WHERE cloud_name = ? AND region_id = ?", cloudName, regionID); err != nil {
+		return fmt.Errorf("清理 SLB 记录失败 (账户=%s, 区域=%s): %w", cloudName, regionID, err)
+	}
 	for _, rec := range records {
-		_, err := db.Exec(
+		_, err := tx.Exec(
 			`INSERT OR REPLACE INTO slb 
-             (lb_id, cloud_name, lb_name, ip_address, band_width, network_type, region_id, lb_status)
-             VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+             (lb_id, cloud_name, lb_name, ip_address, band_width, network_type, region_id, lb_status, vpc_id, vswitch_id)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
 			rec.InstanceID, rec.CloudName, rec.LoadBalancerName, rec.IPAddress, rec.Bandwidth, rec.NetworkType, rec.RegionID, rec.Status,
+			rec.VPCID, rec.VSwitchID,
 		)
 		if err != nil {
 			return fmt.Errorf("插入 SLB 记录失败 (LoadBalancerID=%s): %w", rec.InstanceID, err)
 		}
 	}
-	return nil
+	if err := deleteReleasedLBDetails(tx); err != nil {
+		return err
+	}
+	return tx.Commit()
 }
 
 // 查询所有 SLB 记录（用于 API 层示例）
 func ListSLBRecords() ([]SLBRecord, error) {
 	rows, err := db.Query(
-		"SELECT lb_id, cloud_name, lb_name, ip_address, band_width, network_type, region_id, lb_status FROM slb",
+		"SELECT lb_id, cloud_name, lb_name, ip_address, band_width, network_type, region_id, lb_status, IFNULL(vpc_id, ''), IFNULL(vswitch_id, '') FROM slb",
 	)
 	if err != nil {
 		return nil, fmt.Errorf("查询 SLB 表失败: %w", err)
@@ -273,7 +523,7 @@ func ListSLBRecords() ([]SLBRecord, error) {
 		var rec SLBRecord
 		// 将查询结果的每一行扫描到 SLBRecord 结构体
 		err := rows.Scan(&rec.InstanceID, &rec.CloudName, &rec.LoadBalancerName, &rec.IPAddress,
-			&rec.Bandwidth, &rec.NetworkType, &rec.RegionID, &rec.Status)
+			&rec.Bandwidth, &rec.NetworkType, &rec.RegionID, &rec.Status, &rec.VPCID, &rec.VSwitchID)
 		if err != nil {
 			return nil, fmt.Errorf("读取 SLB 行数据失败: %w", err)
 		}
@@ -297,28 +547,53 @@ type RedisRecord struct {
 	InstanceType     string
 	ConnectionString string
 	IPAddress        string
+	VPCID            string
+	VSwitchID        string
+	EngineVersion    string // 引擎版本
+	Architecture     string // 架构（cluster/standard/rwsplit）
+	ShardCount       int64  // 分片数，非集群架构为 1
+	ZoneID           string // 可用区
+	Status           string // 实例状态（Normal/Inactive 等）
 }
 
-func SaveRedisRecords(records []RedisRecord) error {
+// SaveRedisRecords 覆盖保存指定账户、区域下的全部 Tair Redis 实例，已释放的实例及其连接地址会从表中删除
+func SaveRedisRecords(cloudName string, regionID string, records []RedisRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM redis WHERE cloud_name = ? AND region_id = ?", cloudName, regionID); err != nil {
+		return fmt.Errorf("清理 Tair Redis 记录失败 (账户=%s, 区域=%s): %w", cloudName, regionID, err)
+	}
 	for _, rec := range records {
-		_, err := db.Exec(
+		_, err := tx.Exec(
 			`INSERT OR REPLACE INTO redis 
-             (instance_id, cloud_name, instance_name, port, region_id, capacity, instance_class, qps, band_width, connections, instance_type, connection_string, ip_address)
-             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+             (instance_id, cloud_name, instance_name, port, region_id, capacity, instance_class, qps, band_width, connections, instance_type, connection_string, ip_address, vpc_id, vswitch_id,
+              engine_version, architecture, shard_count, zone_id, status)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
 			rec.InstanceID, rec.CloudName, rec.InstanceName, rec.Port, rec.RegionId, rec.Capacity, rec.InstanceClass, rec.QPS,
-			rec.Bandwidth, rec.Connections, rec.InstanceType, rec.ConnectionString, rec.IPAddress,
+			rec.Bandwidth, rec.Connections, rec.InstanceType, rec.ConnectionString, rec.IPAddress, rec.VPCID, rec.VSwitchID,
+			rec.EngineVersion, rec.Architecture, rec.ShardCount, rec.ZoneID, rec.Status,
 		)
 		if err != nil {
 			return fmt.Errorf("插入 Tair Redis 记录失败 (InstanceID=%s): %w", rec.InstanceID, err)
 		}
 	}
-	return nil
+	// 已释放实例的连接地址不会再被同步覆盖，需一并清理
+	if err := deleteOrphans(tx, "SELECT instance_id FROM redis", "instance_id", "redis_endpoints"); err != nil {
+		return err
+	}
+	return tx.Commit()
 }
 
 // 查询所有 Tair Redis 记录（用于 API 层示例）
 func ListRedisRecords() ([]RedisRecord, error) {
 	rows, err := db.Query(
-		"SELECT instance_id, cloud_name, instance_name, port, region_id, capacity, instance_class, qps, band_width, connections, instance_type, connection_string, ip_address FROM redis",
+		`SELECT instance_id, cloud_name, instance_name, port, region_id, capacity, instance_class, qps, band_width, connections, instance_type, connection_string, ip_address, IFNULL(vpc_id, ''), IFNULL(vswitch_id, ''),
+		        IFNULL(engine_version, ''), IFNULL(architecture, ''), IFNULL(shard_count, 0), IFNULL(zone_id, ''), IFNULL(status, '')
+		 FROM redis`,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("查询 Tair Redis 表失败: %w", err)
@@ -330,7 +605,8 @@ func ListRedisRecords() ([]RedisRecord, error) {
 		var rec RedisRecord
 		// 将查询结果的每一行扫描到 RDSRecord 结构体
 		err := rows.Scan(&rec.InstanceID, &rec.CloudName, &rec.InstanceName, &rec.Port, &rec.RegionId, &rec.Capacity, &rec.InstanceClass, &rec.QPS,
-			&rec.Bandwidth, &rec.Connections, &rec.InstanceType, &rec.ConnectionString, &rec.IPAddress)
+			&rec.Bandwidth, &rec.Connections, &rec.InstanceType, &rec.ConnectionString, &rec.IPAddress, &rec.VPCID, &rec.VSwitchID,
+			&rec.EngineVersion, &rec.Architecture, &rec.ShardCount, &rec.ZoneID, &rec.Status)
 		if err != nil {
 			return nil, fmt.Errorf("读取 Tair Redis 行数据失败: %w", err)
 		}
@@ -350,27 +626,44 @@ type PolarDBRecord struct {
 	Description      string
 	MemorySize       int64
 	ConnectionString string
+	VPCID            string
+	VSwitchID        string
 }
 
-func SavePolarDBRecords(records []PolarDBRecord) error {
+// SavePolarDBRecords 覆盖保存指定账户、区域下的全部 PolarDB 集群，已释放的集群及其节点、连接地址会从表中删除
+func SavePolarDBRecords(cloudName string, regionID string, records []PolarDBRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM polardb WHERE cloud_name = ? AND region_id = ?", cloudName, regionID); err != nil {
+		return fmt.Errorf("清理 PolarDB 记录失败 (账户=%s, 区域=%s): %w", cloudName, regionID, err)
+	}
 	for _, rec := range records {
-		_, err := db.Exec(
+		_, err := tx.Exec(
 			`INSERT OR REPLACE INTO polardb 
-             (dbcluster_id, cloud_name, engine, region_id, db_cluster_status, dbnode_number, dbcluster_description, memory_size, connection_string)
-             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+             (dbcluster_id, cloud_name, engine, region_id, db_cluster_status, dbnode_number, dbcluster_description, memory_size, connection_string, vpc_id, vswitch_id)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
 			rec.InstanceID, rec.CloudName, rec.Engine, rec.RegionID, rec.Status, rec.DBNodeCount, rec.Description, rec.MemorySize, rec.ConnectionString,
+			rec.VPCID, rec.VSwitchID,
 		)
 		if err != nil {
 			return fmt.Errorf("插入 PolarDB 记录失败 (DBClusterID=%s): %w", rec.InstanceID, err)
 		}
 	}
-	return nil
+	// 已释放集群的节点及连接地址不会再被同步覆盖，需一并清理
+	if err := deleteOrphans(tx, "SELECT dbcluster_id FROM polardb", "cluster_id", "polardb_nodes", "polardb_endpoints"); err != nil {
+		return err
+	}
+	return tx.Commit()
 }
 
 // 查询所有 Polardb 记录（用于 API 层示例）
 func ListPolarDBRecords() ([]PolarDBRecord, error) {
 	rows, err := db.Query(
-		"SELECT dbcluster_id, cloud_name, engine, region_id, db_cluster_status, dbnode_number, dbcluster_description, memory_size, connection_string FROM polardb",
+		"SELECT dbcluster_id, cloud_name, engine, region_id, db_cluster_status, dbnode_number, dbcluster_description, memory_size, connection_string, IFNULL(vpc_id, ''), IFNULL(vswitch_id, '') FROM polardb",
 	)
 	if err != nil {
 		return nil, fmt.Errorf("查询 PolarDB 表失败: %w", err)
@@ -382,7 +675,7 @@ func ListPolarDBRecords() ([]PolarDBRecord, error) {
 		var rec PolarDBRecord
 		// 将查询结果的每一行扫描到 PolarDBRecord 结构体
 		err := rows.Scan(&rec.InstanceID, &rec.CloudName, &rec.Engine, &rec.RegionID, &rec.Status,
-			&rec.DBNodeCount, &rec.Description, &rec.MemorySize, &rec.ConnectionString)
+			&rec.DBNodeCount, &rec.Description, &rec.MemorySize, &rec.ConnectionString, &rec.VPCID, &rec.VSwitchID)
 		if err != nil {
 			return nil, fmt.Errorf("读取 PolarDB 行数据失败: %w", err)
 		}
@@ -0,0 +1,187 @@
+package database
+
+import (
+	"fmt"
+)
+
+// 云解析 DNS 及内网 DNS（PrivateZone）的域名和解析记录表
+func initDNSTables() error {
+	// 域名/Zone 表
+	_, err := db.Exec(`CREATE TABLE IF NOT EXISTS dns_zones (
+		zone_id TEXT PRIMARY KEY,
+		zone_type TEXT,
+		cloud_name TEXT,
+		zone_name TEXT,
+		record_count INTEGER,
+		version TEXT,
+		vpc_ids TEXT,
+		remark TEXT,
+		create_time TEXT
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 dns_zones 表失败: %w", err)
+	}
+	// 解析记录表
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS dns_records (
+		record_id TEXT PRIMARY KEY,
+		zone_id TEXT,
+		zone_type TEXT,
+		cloud_name TEXT,
+		hostname TEXT,
+		rr TEXT,
+		type TEXT,
+		value TEXT,
+		ttl INTEGER,
+		line TEXT,
+		status TEXT,
+		remark TEXT
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 dns_records 表失败: %w", err)
+	}
+	_, err = db.Exec(`CREATE INDEX IF NOT EXISTS idx_dns_records_hostname ON dns_records (hostname);`)
+	if err != nil {
+		return fmt.Errorf("创建 dns_records 索引失败: %w", err)
+	}
+	return nil
+}
+
+// DNS 域名类型
+const (
+	DNSZoneTypePublic  = "public"  // 云解析 DNS 公网域名
+	DNSZoneTypePrivate = "private" // 内网 DNS（PrivateZone）
+)
+
+// DNS 域名/Zone 数据结构
+type DNSZoneRecord struct {
+	ZoneID      string // 域名ID（公网域名为 DomainId，内网为 ZoneId）
+	ZoneType    string // 域名类型（public/private）
+	CloudName   string // 账户名称
+	ZoneName    string // 域名
+	RecordCount int64  // 解析记录数
+	Version     string // 云解析版本（仅公网域名）
+	VPCIDs      string // 关联的专有网络ID，多个以逗号分隔（仅内网域名）
+	Remark      string // 备注
+	CreateTime  string // 创建时间
+}
+
+// DNS 解析记录数据结构
+type DNSRecord struct {
+	RecordID  string // 记录ID
+	ZoneID    string // 所属域名ID
+	ZoneType  string // 域名类型（public/private）
+	CloudName string // 账户名称
+	Hostname  string // 完整主机名（主机记录 + 域名，小写）
+	RR        string // 主机记录
+	Type      string // 记录类型（A/AAAA/CNAME/MX/TXT 等）
+	Value     string // 记录值
+	TTL       int64  // TTL（秒）
+	Line      string // 解析线路
+	Status    string // 状态（ENABLE/DISABLE）
+	Remark    string // 备注
+}
+
+// SaveDNSZoneRecords 覆盖保存指定账户下某一类型（公网/内网）的全部域名，已删除的域名及其解析记录会从表中删除
+func SaveDNSZoneRecords(cloudName string, zoneType string, records []DNSZoneRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM dns_zones WHERE cloud_name = ? AND zone_type = ?", cloudName, zoneType); err != nil {
+		return fmt.Errorf("清理 DNS 域名记录失败 (账户=%s, 类型=%s): %w", cloudName, zoneType, err)
+	}
+	for _, rec := range records {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO dns_zones
+             (zone_id, zone_type, cloud_name, zone_name, record_count, version, vpc_ids, remark, create_time)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			rec.ZoneID, rec.ZoneType, rec.CloudName, rec.ZoneName, rec.RecordCount, rec.Version, rec.VPCIDs, rec.Remark, rec.CreateTime,
+		)
+		if err != nil {
+			return fmt.Errorf("插入 DNS 域名记录失败 (ZoneID=%s): %w", rec.ZoneID, err)
+		}
+	}
+	// 已删除域名的解析记录不会再被同步覆盖，需一并清理
+	if err := deleteOrphans(tx, "SELECT zone_id FROM dns_zones", "zone_id", "dns_records"); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// 查询所有 DNS 域名记录
+func ListDNSZoneRecords() ([]DNSZoneRecord, error) {
+	rows, err := db.Query(
+		`SELECT zone_id, zone_type, cloud_name, zone_name, record_count, version, vpc_ids, remark, create_time
+		 FROM dns_zones ORDER BY zone_type, zone_name`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询 DNS 域名表失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []DNSZoneRecord
+	for rows.Next() {
+		var rec DNSZoneRecord
+		err := rows.Scan(&rec.ZoneID, &rec.ZoneType, &rec.CloudName, &rec.ZoneName, &rec.RecordCount, &rec.Version, &rec.VPCIDs,
+			&rec.Remark, &rec.CreateTime)
+		if err != nil {
+			return nil, fmt.Errorf("读取 DNS 域名行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
+
+// SaveDNSRecords 覆盖保存指定域名的解析记录
+func SaveDNSRecords(zoneID string, records []DNSRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM dns_records WHERE zone_id = ?", zoneID); err != nil {
+		return fmt.Errorf("清理 DNS 解析记录失败 (ZoneID=%s): %w", zoneID, err)
+	}
+	for _, rec := range records {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO dns_records
+             (record_id, zone_id, zone_type, cloud_name, hostname, rr, type, value, ttl, line, status, remark)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			rec.RecordID, zoneID, rec.ZoneType, rec.CloudName, rec.Hostname, rec.RR, rec.Type, rec.Value, rec.TTL, rec.Line,
+			rec.Status, rec.Remark,
+		)
+		if err != nil {
+			return fmt.Errorf("插入 DNS 解析记录失败 (RecordID=%s): %w", rec.RecordID, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// 查询 DNS 解析记录，zoneID 为空时返回全部域名的记录
+func ListDNSRecords(zoneID string) ([]DNSRecord, error) {
+	rows, err := db.Query(
+		`SELECT record_id, zone_id, zone_type, cloud_name, hostname, rr, type, value, ttl, line, status, remark
+		 FROM dns_records WHERE ? = '' OR zone_id = ?
+		 ORDER BY hostname, type`,
+		zoneID, zoneID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询 DNS 解析记录表失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []DNSRecord
+	for rows.Next() {
+		var rec DNSRecord
+		err := rows.Scan(&rec.RecordID, &rec.ZoneID, &rec.ZoneType, &rec.CloudName, &rec.Hostname, &rec.RR, &rec.Type, &rec.Value,
+			&rec.TTL, &rec.Line, &rec.Status, &rec.Remark)
+		if err != nil {
+			return nil, fmt.Errorf("读取 DNS 解析记录行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
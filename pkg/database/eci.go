@@ -0,0 +1,104 @@
+package database
+
+import (
+	"fmt"
+)
+
+// 弹性容器实例 ECI 容器组表
+func initECITables() error {
+	_, err := db.Exec(`CREATE TABLE IF NOT EXISTS eci_container_groups (
+		container_group_id TEXT PRIMARY KEY,
+		cloud_name TEXT,
+		name TEXT,
+		region_id TEXT,
+		zone_id TEXT,
+		status TEXT,
+		cpu REAL,
+		memory REAL,
+		instance_type TEXT,
+		vpc_id TEXT,
+		vswitch_id TEXT,
+		security_group_id TEXT,
+		intranet_ip TEXT,
+		internet_ip TEXT,
+		containers TEXT,
+		create_time TEXT
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 eci_container_groups 表失败: %w", err)
+	}
+	return nil
+}
+
+// ECI 容器组数据结构
+type ECIContainerGroupRecord struct {
+	ContainerGroupID string  // 容器组ID
+	CloudName        string  // 账户名称
+	Name             string  // 容器组名称
+	RegionID         string  // 区域ID
+	ZoneID           string  // 可用区
+	Status           string  // 状态（Running/Pending/Succeeded/Failed 等）
+	CPU              float64 // vCPU 数
+	Memory           float64 // 内存（GiB）
+	InstanceType     string  // 指定的 ECS 规格（按 vCPU/内存创建时为空）
+	VPCID            string  // 专有网络ID
+	VSwitchID        string  // 交换机ID
+	SecurityGroupID  string  // 安全组ID
+	IntranetIP       string  // 内网IP
+	InternetIP       string  // 公网IP
+	Containers       string  // 容器列表（名称=镜像），多个以逗号分隔
+	CreateTime       string  // 创建时间
+}
+
+// SaveECIContainerGroupRecords 覆盖保存指定账户、区域下的全部 ECI 容器组，已删除的容器组会从表中删除
+func SaveECIContainerGroupRecords(cloudName string, regionID string, records []ECIContainerGroupRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM eci_container_groups WHERE cloud_name = ? AND region_id = ?", cloudName, regionID); err != nil {
+		return fmt.Errorf("清理 ECI 容器组记录失败 (账户=%s, 区域=%s): %w", cloudName, regionID, err)
+	}
+	for _, rec := range records {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO eci_container_groups
+             (container_group_id, cloud_name, name, region_id, zone_id, status, cpu, memory, instance_type, vpc_id, vswitch_id,
+              security_group_id, intranet_ip, internet_ip, containers, create_time)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			rec.ContainerGroupID, rec.CloudName, rec.Name, rec.RegionID, rec.ZoneID, rec.Status, rec.CPU, rec.Memory, rec.InstanceType,
+			rec.VPCID, rec.VSwitchID, rec.SecurityGroupID, rec.IntranetIP, rec.InternetIP, rec.Containers, rec.CreateTime,
+		)
+		if err != nil {
+			return fmt.Errorf("插入 ECI 容器组记录失败 (ContainerGroupID=%s): %w", rec.ContainerGroupID, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// 查询所有 ECI 容器组记录
+func ListECIContainerGroupRecords() ([]ECIContainerGroupRecord, error) {
+	rows, err := db.Query(
+		`SELECT container_group_id, cloud_name, name, region_id, zone_id, status, cpu, memory, instance_type, vpc_id, vswitch_id,
+		        security_group_id, intranet_ip, internet_ip, containers, create_time
+		 FROM eci_container_groups`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询 ECI 容器组表失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []ECIContainerGroupRecord
+	for rows.Next() {
+		var rec ECIContainerGroupRecord
+		err := rows.Scan(&rec.ContainerGroupID, &rec.CloudName, &rec.Name, &rec.RegionID, &rec.ZoneID, &rec.Status, &rec.CPU, &rec.Memory,
+			&rec.InstanceType, &rec.VPCID, &rec.VSwitchID, &rec.SecurityGroupID, &rec.IntranetIP, &rec.InternetIP, &rec.Containers,
+			&rec.CreateTime)
+		if err != nil {
+			return nil, fmt.Errorf("读取 ECI 容器组行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
@@ -0,0 +1,118 @@
+package database
+
+import (
+	"fmt"
+)
+
+// 创建弹性公网 IP 表（如不存在）
+func initEIPTables() error {
+	_, err := db.Exec(`CREATE TABLE IF NOT EXISTS eips (
+		allocation_id TEXT PRIMARY KEY,
+		cloud_name TEXT,
+		eip_name TEXT,
+		ip_address TEXT,
+		region_id TEXT,
+		bandwidth INTEGER,
+		charge_type TEXT,
+		internet_charge_type TEXT,
+		instance_type TEXT,
+		instance_id TEXT,
+		status TEXT,
+		allocation_time TEXT,
+		description TEXT,
+		remarks TEXT
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 eips 表失败: %w", err)
+	}
+	// 按 IP 反查 EIP
+	_, err = db.Exec(`CREATE INDEX IF NOT EXISTS idx_eips_ip_address ON eips (ip_address);`)
+	if err != nil {
+		return fmt.Errorf("创建 eips 索引失败: %w", err)
+	}
+	return nil
+}
+
+// EIP 数据结构
+type EIPRecord struct {
+	AllocationID       string // EIP 实例ID
+	CloudName          string // 账户名称
+	Name               string // 名称
+	IPAddress          string // 公网 IP
+	RegionID           string // 区域ID
+	Bandwidth          int64  // 带宽峰值(Mbps)
+	ChargeType         string // 付费类型（PostPaid/PrePaid）
+	InternetChargeType string // 计费方式（PayByBandwidth/PayByTraffic）
+	InstanceType       string // 绑定的实例类型（EcsInstance/SlbInstance/Nat/NetworkInterface 等）
+	InstanceID         string // 绑定的实例ID，未绑定时为空
+	Status             string // 状态（InUse/Available 等）
+	AllocationTime     string // 创建时间
+	Description        string // 描述
+}
+
+// SaveEIPRecords 覆盖保存指定账户、区域下的全部 EIP。
+// 已释放的 EIP 会从表中删除，避免闲置报表中出现已不存在的地址。
+func SaveEIPRecords(cloudName string, regionID string, records []EIPRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM eips WHERE cloud_name = ? AND region_id = ?", cloudName, regionID); err != nil {
+		return fmt.Errorf("清理 EIP 记录失败 (账户=%s, 区域=%s): %w", cloudName, regionID, err)
+	}
+	for _, rec := range records {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO eips
+             (allocation_id, cloud_name, eip_name, ip_address, region_id, bandwidth, charge_type, internet_charge_type, instance_type, instance_id, status, allocation_time, description)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			rec.AllocationID, rec.CloudName, rec.Name, rec.IPAddress, rec.RegionID, rec.Bandwidth, rec.ChargeType,
+			rec.InternetChargeType, rec.InstanceType, rec.InstanceID, rec.Status, rec.AllocationTime, rec.Description,
+		)
+		if err != nil {
+			return fmt.Errorf("插入 EIP 记录失败 (AllocationID=%s): %w", rec.AllocationID, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// 查询所有 EIP 记录
+func ListEIPRecords() ([]EIPRecord, error) {
+	rows, err := db.Query(
+		`SELECT allocation_id, cloud_name, eip_name, ip_address, region_id, bandwidth, charge_type, internet_charge_type,
+		        instance_type, instance_id, status, allocation_time, description
+		 FROM eips`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询 EIP 表失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []EIPRecord
+	for rows.Next() {
+		var rec EIPRecord
+		err := rows.Scan(&rec.AllocationID, &rec.CloudName, &rec.Name, &rec.IPAddress, &rec.RegionID, &rec.Bandwidth,
+			&rec.ChargeType, &rec.InternetChargeType, &rec.InstanceType, &rec.InstanceID, &rec.Status, &rec.AllocationTime, &rec.Description)
+		if err != nil {
+			return nil, fmt.Errorf("读取 EIP 行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
+
+// 查询未绑定任何实例的 EIP（闲置但仍在计费）
+func ListUnboundEIPRecords() ([]EIPRecord, error) {
+	all, err := ListEIPRecords()
+	if err != nil {
+		return nil, err
+	}
+	var results []EIPRecord
+	for _, rec := range all {
+		if rec.InstanceID == "" || rec.Status == "Available" {
+			results = append(results, rec)
+		}
+	}
+	return results, nil
+}
@@ -0,0 +1,231 @@
+package database
+
+import (
+	"fmt"
+)
+
+// 弹性伸缩 ESS 伸缩组、伸缩配置及伸缩组内实例表
+func initESSTables() error {
+	// 伸缩组表
+	_, err := db.Exec(`CREATE TABLE IF NOT EXISTS ess_scaling_groups (
+		scaling_group_id TEXT PRIMARY KEY,
+		cloud_name TEXT,
+		name TEXT,
+		region_id TEXT,
+		status TEXT,
+		min_size INTEGER,
+		max_size INTEGER,
+		desired_capacity INTEGER,
+		total_capacity INTEGER,
+		active_configuration_id TEXT,
+		launch_template_id TEXT,
+		vpc_id TEXT,
+		vswitch_ids TEXT,
+		load_balancer_ids TEXT,
+		server_group_ids TEXT,
+		db_instance_ids TEXT,
+		create_time TEXT
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 ess_scaling_groups 表失败: %w", err)
+	}
+	// 伸缩配置表
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS ess_scaling_configurations (
+		configuration_id TEXT PRIMARY KEY,
+		scaling_group_id TEXT,
+		name TEXT,
+		instance_types TEXT,
+		cpu INTEGER,
+		memory INTEGER,
+		image_id TEXT,
+		image_name TEXT,
+		security_group_id TEXT,
+		status TEXT,
+		create_time TEXT
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 ess_scaling_configurations 表失败: %w", err)
+	}
+	// 伸缩组内实例表，用于给 ECS 实例标注所属伸缩组
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS ess_instances (
+		instance_id TEXT PRIMARY KEY,
+		scaling_group_id TEXT,
+		configuration_id TEXT,
+		lifecycle_state TEXT,
+		health_status TEXT,
+		creation_type TEXT,
+		create_time TEXT
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 ess_instances 表失败: %w", err)
+	}
+	return nil
+}
+
+// ESS 伸缩组数据结构
+type ScalingGroupRecord struct {
+	ScalingGroupID        string // 伸缩组ID
+	CloudName             string // 账户名称
+	Name                  string // 伸缩组名称
+	RegionID              string // 区域ID
+	Status                string // 状态（Active/Inactive/Deleting）
+	MinSize               int64  // 最小实例数
+	MaxSize               int64  // 最大实例数
+	DesiredCapacity       int64  // 期望实例数（未开启期望实例数时为 0）
+	TotalCapacity         int64  // 当前实例总数
+	ActiveConfigurationID string // 生效的伸缩配置ID
+	LaunchTemplateID      string // 启动模板ID（使用启动模板时）
+	VPCID                 string // 专有网络ID
+	VSwitchIDs            string // 交换机ID，多个以逗号分隔
+	LoadBalancerIDs       string // 关联的负载均衡实例ID，多个以逗号分隔
+	ServerGroupIDs        string // 关联的 ALB/NLB 服务器组ID，多个以逗号分隔
+	DBInstanceIDs         string // 关联的数据库实例ID，多个以逗号分隔
+	CreateTime            string // 创建时间
+}
+
+// ESS 伸缩配置数据结构
+type ScalingConfigurationRecord struct {
+	ConfigurationID string // 伸缩配置ID
+	ScalingGroupID  string // 所属伸缩组ID
+	Name            string // 伸缩配置名称
+	InstanceTypes   string // 实例规格，多个以逗号分隔
+	CPU             int64  // vCPU 数
+	Memory          int64  // 内存（GiB）
+	ImageID         string // 镜像ID
+	ImageName       string // 镜像名称
+	SecurityGroupID string // 安全组ID
+	Status          string // 状态（Active/Inactive）
+	CreateTime      string // 创建时间
+}
+
+// ESS 伸缩组内实例数据结构
+type ScalingInstanceRecord struct {
+	InstanceID      string // ECS 实例ID
+	ScalingGroupID  string // 伸缩组ID
+	ConfigurationID string // 创建实例所用的伸缩配置ID
+	LifecycleState  string // 生命周期状态（InService/Pending/Removing 等）
+	HealthStatus    string // 健康状态
+	CreationType    string // 加入方式（AutoCreated/Attached 等）
+	CreateTime      string // 加入伸缩组时间
+}
+
+// SaveScalingGroupRecords 覆盖保存指定账户、区域下的全部伸缩组，已删除伸缩组的伸缩配置和组内实例会一并删除
+func SaveScalingGroupRecords(cloudName string, regionID string, records []ScalingGroupRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM ess_scaling_groups WHERE cloud_name = ? AND region_id = ?", cloudName, regionID); err != nil {
+		return fmt.Errorf("清理伸缩组记录失败 (账户=%s, 区域=%s): %w", cloudName, regionID, err)
+	}
+	for _, rec := range records {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO ess_scaling_groups
+             (scaling_group_id, cloud_name, name, region_id, status, min_size, max_size, desired_capacity, total_capacity,
+              active_configuration_id, launch_template_id, vpc_id, vswitch_ids, load_balancer_ids, server_group_ids, db_instance_ids, create_time)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			rec.ScalingGroupID, rec.CloudName, rec.Name, rec.RegionID, rec.Status, rec.MinSize, rec.MaxSize, rec.DesiredCapacity,
+			rec.TotalCapacity, rec.ActiveConfigurationID, rec.LaunchTemplateID, rec.VPCID, rec.VSwitchIDs, rec.LoadBalancerIDs,
+			rec.ServerGroupIDs, rec.DBInstanceIDs, rec.CreateTime,
+		)
+		if err != nil {
+			return fmt.Errorf("插入伸缩组记录失败 (ScalingGroupID=%s): %w", rec.ScalingGroupID, err)
+		}
+	}
+	// 已删除伸缩组的伸缩配置和组内实例不会再被同步覆盖，需一并清理
+	if err := deleteOrphans(tx, "SELECT scaling_group_id FROM ess_scaling_groups", "scaling_group_id", "ess_scaling_configurations", "ess_instances"); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// 查询所有伸缩组记录
+func ListScalingGroupRecords() ([]ScalingGroupRecord, error) {
+	rows, err := db.Query(
+		`SELECT scaling_group_id, cloud_name, name, region_id, status, min_size, max_size, desired_capacity, total_capacity,
+		        active_configuration_id, launch_template_id, vpc_id, vswitch_ids, load_balancer_ids, server_group_ids, db_instance_ids, create_time
+		 FROM ess_scaling_groups`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询伸缩组表失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []ScalingGroupRecord
+	for rows.Next() {
+		var rec ScalingGroupRecord
+		err := rows.Scan(&rec.ScalingGroupID, &rec.CloudName, &rec.Name, &rec.RegionID, &rec.Status, &rec.MinSize, &rec.MaxSize,
+			&rec.DesiredCapacity, &rec.TotalCapacity, &rec.ActiveConfigurationID, &rec.LaunchTemplateID, &rec.VPCID, &rec.VSwitchIDs,
+			&rec.LoadBalancerIDs, &rec.ServerGroupIDs, &rec.DBInstanceIDs, &rec.CreateTime)
+		if err != nil {
+			return nil, fmt.Errorf("读取伸缩组行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
+
+// SaveScalingGroupDetails 覆盖保存指定伸缩组的伸缩配置及组内实例
+func SaveScalingGroupDetails(scalingGroupID string, configurations []ScalingConfigurationRecord, instances []ScalingInstanceRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, table := range []string{"ess_scaling_configurations", "ess_instances"} {
+		if _, err := tx.Exec("DELETE FROM "+table+" WHERE scaling_group_id = ?", scalingGroupID); err != nil {
+			return fmt.Errorf("清理 %s 失败 (ScalingGroupID=%s): %w", table, scalingGroupID, err)
+		}
+	}
+	for _, rec := range configurations {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO ess_scaling_configurations
+             (configuration_id, scaling_group_id, name, instance_types, cpu, memory, image_id, image_name, security_group_id, status, create_time)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			rec.ConfigurationID, scalingGroupID, rec.Name, rec.InstanceTypes, rec.CPU, rec.Memory, rec.ImageID, rec.ImageName,
+			rec.SecurityGroupID, rec.Status, rec.CreateTime,
+		)
+		if err != nil {
+			return fmt.Errorf("插入伸缩配置记录失败 (ConfigurationID=%s): %w", rec.ConfigurationID, err)
+		}
+	}
+	for _, rec := range instances {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO ess_instances
+             (instance_id, scaling_group_id, configuration_id, lifecycle_state, health_status, creation_type, create_time)
+             VALUES (?, ?, ?, ?, ?, ?, ?)`,
+			rec.InstanceID, scalingGroupID, rec.ConfigurationID, rec.LifecycleState, rec.HealthStatus, rec.CreationType, rec.CreateTime,
+		)
+		if err != nil {
+			return fmt.Errorf("插入伸缩组实例记录失败 (InstanceID=%s): %w", rec.InstanceID, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// 查询所有伸缩配置，按伸缩组ID分组返回
+func ListScalingConfigurations() (map[string][]ScalingConfigurationRecord, error) {
+	rows, err := db.Query(
+		`SELECT configuration_id, scaling_group_id, name, instance_types, cpu, memory, image_id, image_name, security_group_id, status, create_time
+		 FROM ess_scaling_configurations ORDER BY create_time`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询伸缩配置表失败: %w", err)
+	}
+	defer rows.Close()
+
+	results := map[string][]ScalingConfigurationRecord{}
+	for rows.Next() {
+		var rec ScalingConfigurationRecord
+		err := rows.Scan(&rec.ConfigurationID, &rec.ScalingGroupID, &rec.Name, &rec.InstanceTypes, &rec.CPU, &rec.Memory, &rec.ImageID,
+			&rec.ImageName, &rec.SecurityGroupID, &rec.Status, &rec.CreateTime)
+		if err != nil {
+			return nil, fmt.Errorf("读取伸缩配置行数据失败: %w", err)
+		}
+		results[rec.ScalingGroupID] = append(results[rec.ScalingGroupID], rec)
+	}
+	return results, nil
+}
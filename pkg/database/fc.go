@@ -0,0 +1,251 @@
+package database
+
+import (
+	"fmt"
+)
+
+// 函数计算 FC 服务、函数及触发器表
+func initFCTables() error {
+	// 服务表
+	_, err := db.Exec(`CREATE TABLE IF NOT EXISTS fc_services (
+		service_id TEXT PRIMARY KEY,
+		cloud_name TEXT,
+		region_id TEXT,
+		service_name TEXT,
+		description TEXT,
+		role TEXT,
+		internet_access INTEGER,
+		vpc_id TEXT,
+		vswitch_ids TEXT,
+		security_group_id TEXT,
+		log_project TEXT,
+		log_store TEXT,
+		create_time TEXT,
+		modify_time TEXT
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 fc_services 表失败: %w", err)
+	}
+	// 函数表
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS fc_functions (
+		function_id TEXT PRIMARY KEY,
+		service_id TEXT,
+		function_name TEXT,
+		runtime TEXT,
+		handler TEXT,
+		memory_size INTEGER,
+		cpu REAL,
+		timeout INTEGER,
+		instance_type TEXT,
+		description TEXT,
+		create_time TEXT,
+		modify_time TEXT
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 fc_functions 表失败: %w", err)
+	}
+	// 触发器表
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS fc_triggers (
+		trigger_id TEXT PRIMARY KEY,
+		service_id TEXT,
+		function_id TEXT,
+		trigger_name TEXT,
+		trigger_type TEXT,
+		qualifier TEXT,
+		source_arn TEXT,
+		create_time TEXT
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 fc_triggers 表失败: %w", err)
+	}
+	return nil
+}
+
+// FC 服务数据结构
+type FCServiceRecord struct {
+	ServiceID       string // 服务ID
+	CloudName       string // 账户名称
+	RegionID        string // 区域ID
+	ServiceName     string // 服务名称
+	Description     string // 描述
+	Role            string // 服务角色 ARN
+	InternetAccess  bool   // 是否允许函数访问公网
+	VPCID           string // 专有网络ID（未配置 VPC 时为空）
+	VSwitchIDs      string // 交换机ID，多个以逗号分隔
+	SecurityGroupID string // 安全组ID
+	LogProject      string // 日志投递的 SLS Project
+	LogStore        string // 日志投递的 SLS Logstore
+	CreateTime      string // 创建时间
+	ModifyTime      string // 修改时间
+}
+
+// FC 函数数据结构
+type FCFunctionRecord struct {
+	FunctionID   string  // 函数ID
+	ServiceID    string  // 所属服务ID
+	FunctionName string  // 函数名称
+	Runtime      string  // 运行时（python3.10/nodejs18/custom-container 等）
+	Handler      string  // 函数入口
+	MemorySize   int64   // 内存规格（MB）
+	CPU          float64 // vCPU 规格（未单独配置时为 0）
+	Timeout      int64   // 超时时间（秒）
+	InstanceType string  // 实例类型（e1/c1/fc.gpu.tesla.1 等）
+	Description  string  // 描述
+	CreateTime   string  // 创建时间
+	ModifyTime   string  // 修改时间
+}
+
+// FC 触发器数据结构
+type FCTriggerRecord struct {
+	TriggerID   string // 触发器ID
+	ServiceID   string // 所属服务ID
+	FunctionID  string // 所属函数ID
+	TriggerName string // 触发器名称
+	TriggerType string // 触发器类型（http/timer/oss/log/mns_topic 等）
+	Qualifier   string // 触发的服务版本或别名
+	SourceARN   string // 事件源 ARN（http/timer 触发器为空）
+	CreateTime  string // 创建时间
+}
+
+// SaveFCServiceRecords 覆盖保存指定账户、区域下的全部函数计算服务，已删除服务的函数和触发器会一并删除
+func SaveFCServiceRecords(cloudName string, regionID string, records []FCServiceRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM fc_services WHERE cloud_name = ? AND region_id = ?", cloudName, regionID); err != nil {
+		return fmt.Errorf("清理 FC 服务记录失败 (账户=%s, 区域=%s): %w", cloudName, regionID, err)
+	}
+	for _, rec := range records {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO fc_services
+             (service_id, cloud_name, region_id, service_name, description, role, internet_access, vpc_id, vswitch_ids,
+              security_group_id, log_project, log_store, create_time, modify_time)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			rec.ServiceID, rec.CloudName, rec.RegionID, rec.ServiceName, rec.Description, rec.Role, rec.InternetAccess, rec.VPCID,
+			rec.VSwitchIDs, rec.SecurityGroupID, rec.LogProject, rec.LogStore, rec.CreateTime, rec.ModifyTime,
+		)
+		if err != nil {
+			return fmt.Errorf("插入 FC 服务记录失败 (ServiceID=%s): %w", rec.ServiceID, err)
+		}
+	}
+	// 已删除服务的函数和触发器不会再被同步覆盖，需一并清理
+	if err := deleteOrphans(tx, "SELECT service_id FROM fc_services", "service_id", "fc_functions", "fc_triggers"); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// 查询所有 FC 服务记录
+func ListFCServiceRecords() ([]FCServiceRecord, error) {
+	rows, err := db.Query(
+		`SELECT service_id, cloud_name, region_id, service_name, description, role, internet_access, vpc_id, vswitch_ids,
+		        security_group_id, log_project, log_store, create_time, modify_time
+		 FROM fc_services`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询 FC 服务表失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []FCServiceRecord
+	for rows.Next() {
+		var rec FCServiceRecord
+		err := rows.Scan(&rec.ServiceID, &rec.CloudName, &rec.RegionID, &rec.ServiceName, &rec.Description, &rec.Role, &rec.InternetAccess,
+			&rec.VPCID, &rec.VSwitchIDs, &rec.SecurityGroupID, &rec.LogProject, &rec.LogStore, &rec.CreateTime, &rec.ModifyTime)
+		if err != nil {
+			return nil, fmt.Errorf("读取 FC 服务行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
+
+// SaveFCServiceDetails 覆盖保存指定服务下的函数及触发器
+func SaveFCServiceDetails(serviceID string, functions []FCFunctionRecord, triggers []FCTriggerRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, table := range []string{"fc_functions", "fc_triggers"} {
+		if _, err := tx.Exec("DELETE FROM "+table+" WHERE service_id = ?", serviceID); err != nil {
+			return fmt.Errorf("清理 %s 失败 (ServiceID=%s): %w", table, serviceID, err)
+		}
+	}
+	for _, rec := range functions {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO fc_functions
+             (function_id, service_id, function_name, runtime, handler, memory_size, cpu, timeout, instance_type, description, create_time, modify_time)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			rec.FunctionID, serviceID, rec.FunctionName, rec.Runtime, rec.Handler, rec.MemorySize, rec.CPU, rec.Timeout, rec.InstanceType,
+			rec.Description, rec.CreateTime, rec.ModifyTime,
+		)
+		if err != nil {
+			return fmt.Errorf("插入 FC 函数记录失败 (FunctionID=%s): %w", rec.FunctionID, err)
+		}
+	}
+	for _, rec := range triggers {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO fc_triggers
+             (trigger_id, service_id, function_id, trigger_name, trigger_type, qualifier, source_arn, create_time)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+			rec.TriggerID, serviceID, rec.FunctionID, rec.TriggerName, rec.TriggerType, rec.Qualifier, rec.SourceARN, rec.CreateTime,
+		)
+		if err != nil {
+			return fmt.Errorf("插入 FC 触发器记录失败 (TriggerID=%s): %w", rec.TriggerID, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// 查询所有 FC 函数记录
+func ListFCFunctionRecords() ([]FCFunctionRecord, error) {
+	rows, err := db.Query(
+		`SELECT function_id, service_id, function_name, runtime, handler, memory_size, cpu, timeout, instance_type, description, create_time, modify_time
+		 FROM fc_functions`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询 FC 函数表失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []FCFunctionRecord
+	for rows.Next() {
+		var rec FCFunctionRecord
+		err := rows.Scan(&rec.FunctionID, &rec.ServiceID, &rec.FunctionName, &rec.Runtime, &rec.Handler, &rec.MemorySize, &rec.CPU,
+			&rec.Timeout, &rec.InstanceType, &rec.Description, &rec.CreateTime, &rec.ModifyTime)
+		if err != nil {
+			return nil, fmt.Errorf("读取 FC 函数行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
+
+// 查询所有 FC 触发器，按函数ID分组返回
+func ListFCTriggers() (map[string][]FCTriggerRecord, error) {
+	rows, err := db.Query(
+		`SELECT trigger_id, service_id, function_id, trigger_name, trigger_type, qualifier, source_arn, create_time
+		 FROM fc_triggers ORDER BY trigger_name`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询 FC 触发器表失败: %w", err)
+	}
+	defer rows.Close()
+
+	results := map[string][]FCTriggerRecord{}
+	for rows.Next() {
+		var rec FCTriggerRecord
+		err := rows.Scan(&rec.TriggerID, &rec.ServiceID, &rec.FunctionID, &rec.TriggerName, &rec.TriggerType, &rec.Qualifier,
+			&rec.SourceARN, &rec.CreateTime)
+		if err != nil {
+			return nil, fmt.Errorf("读取 FC 触发器行数据失败: %w", err)
+		}
+		results[rec.FunctionID] = append(results[rec.FunctionID], rec)
+	}
+	return results, nil
+}
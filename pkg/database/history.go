@@ -0,0 +1,408 @@
+package database
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// 资源历史表：每次同步后按天记录各账户、区域、资源类型的数量及容量，以及每个资源的完整字段快照
+func initHistoryTables() error {
+	_, err := db.Exec(`CREATE TABLE IF NOT EXISTS stats_history (
+		snapshot_date TEXT,
+		cloud_name TEXT,
+		region_id TEXT,
+		resource_type TEXT,
+		count INTEGER,
+		vcpu REAL,
+		memory REAL,
+		storage REAL,
+		PRIMARY KEY (snapshot_date, cloud_name, region_id, resource_type)
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 stats_history 表失败: %w", err)
+	}
+
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS resource_snapshots (
+		snapshot_date TEXT,
+		resource_type TEXT,
+		resource_id TEXT,
+		cloud_name TEXT,
+		region_id TEXT,
+		name TEXT,
+		attributes TEXT,
+		PRIMARY KEY (snapshot_date, resource_type, resource_id)
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 resource_snapshots 表失败: %w", err)
+	}
+	return nil
+}
+
+// 统计历史数据点
+type StatsHistoryPoint struct {
+	Date    string  // 快照日期（YYYY-MM-DD）
+	Key     string  // 分组值（账户、区域或资源类型），不分组时为空
+	Count   int64   // 资源数
+	VCPU    float64 // vCPU 合计
+	Memory  float64 // 内存合计（MiB）
+	Storage float64 // 存储合计（GB），ECS 云盘未计入
+}
+
+// SaveStatsSnapshot 覆盖保存指定日期的资源统计快照，同一天多次同步只保留最后一次
+func SaveStatsSnapshot(snapshotDate string) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM stats_history WHERE snapshot_date = ?", snapshotDate); err != nil {
+		return fmt.Errorf("清理统计快照失败 (日期=%s): %w", snapshotDate, err)
+	}
+	_, err = tx.Exec(
+		`INSERT INTO stats_history (snapshot_date, cloud_name, region_id, resource_type, count, vcpu, memory, storage)
+		 SELECT ?, COALESCE(cloud_name, ''), COALESCE(region_id, ''), resource_type, COUNT(*),
+		        COALESCE(SUM(cpu), 0), COALESCE(SUM(memory), 0), COALESCE(SUM(storage), 0)
+		 FROM `+resourceUnionSQL()+`
+		 GROUP BY COALESCE(cloud_name, ''), COALESCE(region_id, ''), resource_type`,
+		snapshotDate,
+	)
+	if err != nil {
+		return fmt.Errorf("插入统计快照失败 (日期=%s): %w", snapshotDate, err)
+	}
+	return tx.Commit()
+}
+
+// 查询统计历史时间序列，groupBy 为 account/region/type 时按对应维度分组，其余值不分组；from/to 为空表示不限
+func ListStatsHistory(f StatsFilter, groupBy string, from string, to string) ([]StatsHistoryPoint, error) {
+	keyColumn := "''"
+	switch groupBy {
+	case "account":
+		keyColumn = "cloud_name"
+	case "region":
+		keyColumn = "region_id"
+	case "type":
+		keyColumn = "resource_type"
+	}
+
+	where, args := f.where("cloud_name", "region_id", "resource_type")
+	if from != "" {
+		where = appendCondition(where, "snapshot_date >= ?")
+		args = append(args, from)
+	}
+	if to != "" {
+		where = appendCondition(where, "snapshot_date <= ?")
+		args = append(args, to)
+	}
+
+	rows, err := db.Query(
+		`SELECT snapshot_date, `+keyColumn+`, SUM(count), SUM(vcpu), SUM(memory), SUM(storage) FROM stats_history`+where+
+			` GROUP BY 1, 2 ORDER BY 2, 1`,
+		args...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询统计历史表失败: %w", err)
+	}
+	defer rows.Close()
+
+	results := []StatsHistoryPoint{}
+	for rows.Next() {
+		var rec StatsHistoryPoint
+		if err := rows.Scan(&rec.Date, &rec.Key, &rec.Count, &rec.VCPU, &rec.Memory, &rec.Storage); err != nil {
+			return nil, fmt.Errorf("读取统计历史行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
+
+// appendCondition 向 WHERE 子句追加 AND 条件
+func appendCondition(where string, cond string) string {
+	if where == "" {
+		return " WHERE " + cond
+	}
+	return where + " AND " + cond
+}
+
+// 指定日期之前没有资源快照
+var ErrNoSnapshot = errors.New("未找到不晚于该日期的资源快照")
+
+// 资源变更类型
+const (
+	DiffAdded    = "added"    // 新增
+	DiffReleased = "released" // 释放（后一快照中不存在）
+	DiffChanged  = "changed"  // 字段变更
+)
+
+// 单个字段的变更
+type FieldChange struct {
+	Field  string
+	Before string
+	After  string
+}
+
+// 单个资源的变更
+type ResourceChange struct {
+	ResourceType string
+	ResourceID   string
+	Name         string
+	CloudName    string
+	RegionID     string
+	Action       string        // added/released/changed
+	Fields       []FieldChange // 仅 changed 时有值
+}
+
+// 两个快照之间的资源变更
+type ResourceDiff struct {
+	From    string // 实际使用的起始快照日期
+	To      string // 实际使用的结束快照日期
+	Changes []ResourceChange
+}
+
+// 快照中的单个资源
+type resourceSnapshot struct {
+	ResourceType string
+	ResourceID   string
+	Name         string
+	CloudName    string
+	RegionID     string
+	Attributes   map[string]json.RawMessage
+}
+
+// 不写入资源快照的字段：用量或修改时间每天都可能变化，参与对比只会产生噪声
+var snapshotExcludedColumns = map[string]map[string]bool{
+	"oss_buckets":  {"storage_size": true, "object_count": true},
+	"sls_projects": {"modify_time": true},
+	"fc_services":  {"modify_time": true},
+}
+
+// SaveResourceSnapshot 覆盖保存指定日期的资源快照，每个资源的字段（snapshotExcludedColumns 除外）以 JSON 对象保存
+func SaveResourceSnapshot(snapshotDate string) error {
+	// 先读取各表字段，再在事务内逐表写入快照
+	selects := make([]string, 0, len(statTables))
+	for _, t := range statTables {
+		columns, err := tableColumns(t.table)
+		if err != nil {
+			return err
+		}
+		pairs := make([]string, 0, len(columns))
+		for _, col := range columns {
+			if snapshotExcludedColumns[t.table][col] {
+				continue
+			}
+			pairs = append(pairs, fmt.Sprintf("'%s', %s", col, col))
+		}
+		selects = append(selects, fmt.Sprintf(
+			"SELECT ?, %s, %s, COALESCE(cloud_name, ''), COALESCE(region_id, ''), COALESCE(%s, ''), json_object(%s) FROM %s",
+			t.typeExpr, t.idColumn, t.nameColumn, strings.Join(pairs, ", "), t.table,
+		))
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM resource_snapshots WHERE snapshot_date = ?", snapshotDate); err != nil {
+		return fmt.Errorf("清理资源快照失败 (日期=%s): %w", snapshotDate, err)
+	}
+	for i, query := range selects {
+		_, err := tx.Exec(
+			`INSERT INTO resource_snapshots (snapshot_date, resource_type, resource_id, cloud_name, region_id, name, attributes) `+query,
+			snapshotDate,
+		)
+		if err != nil {
+			return fmt.Errorf("插入资源快照失败 (表=%s, 日期=%s): %w", statTables[i].table, snapshotDate, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// DeleteResourceSnapshotsBefore 删除指定日期之前的资源快照，返回删除的记录数
+func DeleteResourceSnapshotsBefore(snapshotDate string) (int64, error) {
+	result, err := db.Exec("DELETE FROM resource_snapshots WHERE snapshot_date < ?", snapshotDate)
+	if err != nil {
+		return 0, fmt.Errorf("清理过期资源快照失败 (日期=%s): %w", snapshotDate, err)
+	}
+	return result.RowsAffected()
+}
+
+// tableColumns 读取数据表的全部字段名
+func tableColumns(table string) ([]string, error) {
+	rows, err := db.Query("SELECT name FROM pragma_table_info(?)", table)
+	if err != nil {
+		return nil, fmt.Errorf("查询 %s 表结构失败: %w", table, err)
+	}
+	defer rows.Close()
+
+	var columns []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, fmt.Errorf("读取 %s 表结构失败: %w", table, err)
+		}
+		columns = append(columns, name)
+	}
+	return columns, nil
+}
+
+// 查询不晚于指定日期的最近一次快照日期，没有快照时返回空串
+func LatestSnapshotDate(date string) (string, error) {
+	var snapshotDate string
+	err := db.QueryRow(
+		"SELECT COALESCE(MAX(snapshot_date), '') FROM resource_snapshots WHERE snapshot_date <= ?", date,
+	).Scan(&snapshotDate)
+	if err != nil {
+		return "", fmt.Errorf("查询资源快照日期失败: %w", err)
+	}
+	return snapshotDate, nil
+}
+
+// 对比两个日期的资源快照，from/to 取不晚于该日期的最近一次快照，结果按资源类型、变更类型、资源ID排序
+func DiffResourceSnapshots(f StatsFilter, from string, to string) (ResourceDiff, error) {
+	diff := ResourceDiff{Changes: []ResourceChange{}}
+	var err error
+	if diff.From, err = LatestSnapshotDate(from); err != nil {
+		return diff, err
+	}
+	if diff.From == "" {
+		return diff, fmt.Errorf("%w: %s", ErrNoSnapshot, from)
+	}
+	if diff.To, err = LatestSnapshotDate(to); err != nil {
+		return diff, err
+	}
+	if diff.To == "" {
+		return diff, fmt.Errorf("%w: %s", ErrNoSnapshot, to)
+	}
+
+	before, err := listResourceSnapshot(f, diff.From)
+	if err != nil {
+		return diff, err
+	}
+	after, err := listResourceSnapshot(f, diff.To)
+	if err != nil {
+		return diff, err
+	}
+
+	diff.Changes = diffSnapshots(before, after)
+	return diff, nil
+}
+
+// diffSnapshots 对比前后两个快照，返回新增、释放及字段变更的资源，按资源类型、变更类型、资源ID排序
+func diffSnapshots(before, after map[string]resourceSnapshot) []ResourceChange {
+	changes := []ResourceChange{}
+	for key, cur := range after {
+		prev, ok := before[key]
+		if !ok {
+			changes = append(changes, snapshotChange(cur, DiffAdded, nil))
+			continue
+		}
+		if fields := diffAttributes(prev.Attributes, cur.Attributes); len(fields) > 0 {
+			changes = append(changes, snapshotChange(cur, DiffChanged, fields))
+		}
+	}
+	for key, prev := range before {
+		if _, ok := after[key]; !ok {
+			changes = append(changes, snapshotChange(prev, DiffReleased, nil))
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		a, b := changes[i], changes[j]
+		if a.ResourceType != b.ResourceType {
+			return a.ResourceType < b.ResourceType
+		}
+		if a.Action != b.Action {
+			return a.Action < b.Action
+		}
+		return a.ResourceID < b.ResourceID
+	})
+	return changes
+}
+
+// listResourceSnapshot 读取指定日期的资源快照，按 资源类型/资源ID 索引
+func listResourceSnapshot(f StatsFilter, snapshotDate string) (map[string]resourceSnapshot, error) {
+	where, args := f.where("cloud_name", "region_id", "resource_type")
+	where = appendCondition(where, "snapshot_date = ?")
+	args = append(args, snapshotDate)
+
+	rows, err := db.Query(
+		`SELECT resource_type, resource_id, name, cloud_name, region_id, attributes FROM resource_snapshots`+where,
+		args...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询资源快照表失败: %w", err)
+	}
+	defer rows.Close()
+
+	results := map[string]resourceSnapshot{}
+	for rows.Next() {
+		var rec resourceSnapshot
+		var attributes string
+		if err := rows.Scan(&rec.ResourceType, &rec.ResourceID, &rec.Name, &rec.CloudName, &rec.RegionID, &attributes); err != nil {
+			return nil, fmt.Errorf("读取资源快照行数据失败: %w", err)
+		}
+		if err := json.Unmarshal([]byte(attributes), &rec.Attributes); err != nil {
+			return nil, fmt.Errorf("解析资源快照字段失败 (ID=%s): %w", rec.ResourceID, err)
+		}
+		results[rec.ResourceType+"/"+rec.ResourceID] = rec
+	}
+	return results, nil
+}
+
+// snapshotChange 由快照资源生成变更记录
+func snapshotChange(rec resourceSnapshot, action string, fields []FieldChange) ResourceChange {
+	return ResourceChange{
+		ResourceType: rec.ResourceType,
+		ResourceID:   rec.ResourceID,
+		Name:         rec.Name,
+		CloudName:    rec.CloudName,
+		RegionID:     rec.RegionID,
+		Action:       action,
+		Fields:       fields,
+	}
+}
+
+// diffAttributes 按字段名对比两个快照的字段值，返回有变化的字段
+func diffAttributes(before, after map[string]json.RawMessage) []FieldChange {
+	names := map[string]bool{}
+	for name := range before {
+		names[name] = true
+	}
+	for name := range after {
+		names[name] = true
+	}
+
+	var fields []FieldChange
+	for name := range names {
+		if attributeJSON(before[name]) != attributeJSON(after[name]) {
+			fields = append(fields, FieldChange{Field: name, Before: attributeText(before[name]), After: attributeText(after[name])})
+		}
+	}
+	sort.Slice(fields, func(i, j int) bool { return fields[i].Field < fields[j].Field })
+	return fields
+}
+
+// attributeJSON 返回用于比较的 JSON 文本，缺失字段视同 null（如新增字段前的旧快照）
+func attributeJSON(raw json.RawMessage) string {
+	if len(raw) == 0 {
+		return "null"
+	}
+	return string(raw)
+}
+
+// attributeText 将 JSON 字段值转换为展示文本，字符串去掉引号，null 或缺失为空串
+func attributeText(raw json.RawMessage) string {
+	if len(raw) == 0 || string(raw) == "null" {
+		return ""
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+	return string(raw)
+}
@@ -0,0 +1,90 @@
+package database
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+// attrs 将 JSON 对象解析为快照字段，便于构造测试数据
+func attrs(t *testing.T, s string) map[string]json.RawMessage {
+	t.Helper()
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(s), &m); err != nil {
+		t.Fatalf("解析测试数据失败: %v", err)
+	}
+	return m
+}
+
+func TestDiffAttributes(t *testing.T) {
+	tests := []struct {
+		name          string
+		before, after string
+		want          []FieldChange
+	}{
+		{"unchanged", `{"status":"Running","cpu":2}`, `{"status":"Running","cpu":2}`, nil},
+		{"string changed", `{"status":"Running"}`, `{"status":"Stopped"}`, []FieldChange{{"status", "Running", "Stopped"}}},
+		{"number changed", `{"cpu":2}`, `{"cpu":4}`, []FieldChange{{"cpu", "2", "4"}}},
+		{"null vs missing", `{"status":"Running","vpc_id":null}`, `{"status":"Running"}`, nil},
+		{"missing vs null", `{"status":"Running"}`, `{"status":"Running","vpc_id":null}`, nil},
+		{"null to value", `{"vpc_id":null}`, `{"vpc_id":"vpc-1"}`, []FieldChange{{"vpc_id", "", "vpc-1"}}},
+		{"value to empty string", `{"vpc_id":"vpc-1"}`, `{"vpc_id":""}`, []FieldChange{{"vpc_id", "vpc-1", ""}}},
+		{"field added", `{}`, `{"zone_id":"cn-hangzhou-h"}`, []FieldChange{{"zone_id", "", "cn-hangzhou-h"}}},
+		{"field removed", `{"zone_id":"cn-hangzhou-h"}`, `{}`, []FieldChange{{"zone_id", "cn-hangzhou-h", ""}}},
+		{"sorted by field", `{"status":"Running","cpu":2,"memory":4096}`, `{"status":"Stopped","cpu":4,"memory":4096}`,
+			[]FieldChange{{"cpu", "2", "4"}, {"status", "Running", "Stopped"}}},
+	}
+	for _, tt := range tests {
+		got := diffAttributes(attrs(t, tt.before), attrs(t, tt.after))
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: diffAttributes() = %v; want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestDiffSnapshots(t *testing.T) {
+	snapshot := func(resourceType, id, attributes string) resourceSnapshot {
+		return resourceSnapshot{ResourceType: resourceType, ResourceID: id, CloudName: "a", RegionID: "cn-hangzhou", Attributes: attrs(t, attributes)}
+	}
+	index := func(recs ...resourceSnapshot) map[string]resourceSnapshot {
+		m := map[string]resourceSnapshot{}
+		for _, rec := range recs {
+			m[rec.ResourceType+"/"+rec.ResourceID] = rec
+		}
+		return m
+	}
+	change := func(resourceType, id, action string, fields ...FieldChange) ResourceChange {
+		return ResourceChange{ResourceType: resourceType, ResourceID: id, CloudName: "a", RegionID: "cn-hangzhou", Action: action, Fields: fields}
+	}
+
+	tests := []struct {
+		name          string
+		before, after map[string]resourceSnapshot
+		want          []ResourceChange
+	}{
+		{"empty", index(), index(), []ResourceChange{}},
+		{"unchanged", index(snapshot("ecs", "i-1", `{"status":"Running"}`)), index(snapshot("ecs", "i-1", `{"status":"Running"}`)), []ResourceChange{}},
+		{"added", index(), index(snapshot("ecs", "i-1", `{}`)), []ResourceChange{change("ecs", "i-1", DiffAdded)}},
+		{"released", index(snapshot("rds", "rm-1", `{}`)), index(), []ResourceChange{change("rds", "rm-1", DiffReleased)}},
+		{"changed", index(snapshot("ecs", "i-1", `{"status":"Running"}`)), index(snapshot("ecs", "i-1", `{"status":"Stopped"}`)),
+			[]ResourceChange{change("ecs", "i-1", DiffChanged, FieldChange{"status", "Running", "Stopped"})}},
+		{"same id in different types", index(snapshot("ecs", "x-1", `{}`)), index(snapshot("rds", "x-1", `{}`)),
+			[]ResourceChange{change("ecs", "x-1", DiffReleased), change("rds", "x-1", DiffAdded)}},
+		{"sorted by type, action and id",
+			index(snapshot("rds", "rm-1", `{}`), snapshot("ecs", "i-2", `{}`), snapshot("ecs", "i-1", `{"cpu":2}`)),
+			index(snapshot("ecs", "i-3", `{}`), snapshot("ecs", "i-1", `{"cpu":4}`), snapshot("ecs", "i-0", `{}`)),
+			[]ResourceChange{
+				change("ecs", "i-0", DiffAdded),
+				change("ecs", "i-3", DiffAdded),
+				change("ecs", "i-1", DiffChanged, FieldChange{"cpu", "2", "4"}),
+				change("ecs", "i-2", DiffReleased),
+				change("rds", "rm-1", DiffReleased),
+			}},
+	}
+	for _, tt := range tests {
+		got := diffSnapshots(tt.before, tt.after)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: diffSnapshots() = %+v; want %+v", tt.name, got, tt.want)
+		}
+	}
+}
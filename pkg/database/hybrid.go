@@ -0,0 +1,640 @@
+package database
+
+import (
+	"fmt"
+)
+
+// 混合云网络表：VPN 网关、用户网关、IPsec 连接、边界路由器 VBR、物理专线及云企业网 CEN
+func initHybridNetworkTables() error {
+	tables := map[string]string{
+		// VPN 网关表
+		"vpn_gateways": `CREATE TABLE IF NOT EXISTS vpn_gateways (
+			vpn_gateway_id TEXT PRIMARY KEY,
+			cloud_name TEXT,
+			region_id TEXT,
+			name TEXT,
+			vpc_id TEXT,
+			vswitch_id TEXT,
+			internet_ip TEXT,
+			spec TEXT,
+			status TEXT,
+			ipsec_vpn TEXT,
+			ssl_vpn TEXT,
+			create_time TEXT
+		);`,
+		// 用户网关表
+		"customer_gateways": `CREATE TABLE IF NOT EXISTS customer_gateways (
+			customer_gateway_id TEXT PRIMARY KEY,
+			cloud_name TEXT,
+			region_id TEXT,
+			name TEXT,
+			ip_address TEXT,
+			asn INTEGER,
+			description TEXT,
+			create_time TEXT
+		);`,
+		// IPsec 连接表
+		"vpn_connections": `CREATE TABLE IF NOT EXISTS vpn_connections (
+			vpn_connection_id TEXT PRIMARY KEY,
+			cloud_name TEXT,
+			region_id TEXT,
+			name TEXT,
+			vpn_gateway_id TEXT,
+			customer_gateway_id TEXT,
+			local_subnet TEXT,
+			remote_subnet TEXT,
+			status TEXT,
+			create_time TEXT
+		);`,
+		// 边界路由器表
+		"vbrs": `CREATE TABLE IF NOT EXISTS vbrs (
+			vbr_id TEXT PRIMARY KEY,
+			cloud_name TEXT,
+			region_id TEXT,
+			name TEXT,
+			physical_connection_id TEXT,
+			vlan_id INTEGER,
+			local_gateway_ip TEXT,
+			peer_gateway_ip TEXT,
+			peering_subnet_mask TEXT,
+			status TEXT,
+			create_time TEXT
+		);`,
+		// 物理专线表
+		"physical_connections": `CREATE TABLE IF NOT EXISTS physical_connections (
+			physical_connection_id TEXT PRIMARY KEY,
+			cloud_name TEXT,
+			region_id TEXT,
+			name TEXT,
+			access_point_id TEXT,
+			line_operator TEXT,
+			peer_location TEXT,
+			bandwidth INTEGER,
+			port_type TEXT,
+			status TEXT,
+			business_status TEXT,
+			create_time TEXT
+		);`,
+		// 云企业网实例表
+		"cen_instances": `CREATE TABLE IF NOT EXISTS cen_instances (
+			cen_id TEXT PRIMARY KEY,
+			cloud_name TEXT,
+			name TEXT,
+			status TEXT,
+			protection_level TEXT,
+			description TEXT,
+			create_time TEXT
+		);`,
+		// 云企业网已加载网络实例表
+		"cen_child_instances": `CREATE TABLE IF NOT EXISTS cen_child_instances (
+			cen_id TEXT,
+			child_instance_id TEXT,
+			child_instance_type TEXT,
+			child_instance_region_id TEXT,
+			child_instance_owner_id TEXT,
+			status TEXT,
+			attach_time TEXT,
+			PRIMARY KEY (cen_id, child_instance_id)
+		);`,
+		// 云企业网带宽包表
+		"cen_bandwidth_packages": `CREATE TABLE IF NOT EXISTS cen_bandwidth_packages (
+			package_id TEXT PRIMARY KEY,
+			cloud_name TEXT,
+			name TEXT,
+			cen_ids TEXT,
+			bandwidth INTEGER,
+			geographic_region_a TEXT,
+			geographic_region_b TEXT,
+			status TEXT,
+			business_status TEXT,
+			charge_type TEXT,
+			expired_time TEXT
+		);`,
+	}
+	for name, ddl := range tables {
+		if _, err := db.Exec(ddl); err != nil {
+			return fmt.Errorf("创建 %s 表失败: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// CEN 加载的网络实例类型
+const (
+	CENChildVPC = "VPC" // 专有网络
+	CENChildVBR = "VBR" // 边界路由器
+	CENChildCCN = "CCN" // 云连接网
+)
+
+// VPN 网关数据结构
+type VPNGatewayRecord struct {
+	VPNGatewayID string // VPN 网关ID
+	CloudName    string // 账户名称
+	RegionID     string // 区域ID
+	Name         string // 名称
+	VPCID        string // 所属专有网络ID
+	VSwitchID    string // 所属交换机ID
+	InternetIP   string // 公网 IP
+	Spec         string // 带宽规格
+	Status       string // 状态
+	IPsecVPN     string // 是否开启 IPsec-VPN（enable/disable）
+	SSLVPN       string // 是否开启 SSL-VPN（enable/disable）
+	CreateTime   string // 创建时间
+}
+
+// 用户网关数据结构
+type CustomerGatewayRecord struct {
+	CustomerGatewayID string // 用户网关ID
+	CloudName         string // 账户名称
+	RegionID          string // 区域ID
+	Name              string // 名称
+	IPAddress         string // 本地数据中心网关公网 IP
+	ASN               int64  // BGP 自治系统号
+	Description       string // 描述
+	CreateTime        string // 创建时间
+}
+
+// IPsec 连接数据结构
+type VPNConnectionRecord struct {
+	VPNConnectionID   string // IPsec 连接ID
+	CloudName         string // 账户名称
+	RegionID          string // 区域ID
+	Name              string // 名称
+	VPNGatewayID      string // VPN 网关ID（绑定转发路由器时为空）
+	CustomerGatewayID string // 用户网关ID
+	LocalSubnet       string // 本端网段，多个以逗号分隔
+	RemoteSubnet      string // 对端网段，多个以逗号分隔
+	Status            string // 协商状态（ike_sa_not_established/ipsec_sa_established 等）
+	CreateTime        string // 创建时间
+}
+
+// 边界路由器数据结构
+type VBRRecord struct {
+	VBRID                string // 边界路由器ID
+	CloudName            string // 账户名称
+	RegionID             string // 区域ID
+	Name                 string // 名称
+	PhysicalConnectionID string // 所属物理专线ID
+	VLANID               int64  // VLAN ID
+	LocalGatewayIP       string // 阿里云侧互联 IP
+	PeerGatewayIP        string // 客户侧互联 IP
+	PeeringSubnetMask    string // 互联子网掩码
+	Status               string // 状态
+	CreateTime           string // 创建时间
+}
+
+// 物理专线数据结构
+type PhysicalConnectionRecord struct {
+	PhysicalConnectionID string // 物理专线ID
+	CloudName            string // 账户名称
+	RegionID             string // 区域ID
+	Name                 string // 名称
+	AccessPointID        string // 接入点ID
+	LineOperator         string // 运营商
+	PeerLocation         string // 本地 IDC 地址
+	Bandwidth            int64  // 带宽（Mbps）
+	PortType             string // 端口类型
+	Status               string // 状态
+	BusinessStatus       string // 业务状态（Normal/FinancialLocked 等）
+	CreateTime           string // 创建时间
+}
+
+// 云企业网实例数据结构
+type CENRecord struct {
+	CENID           string // 云企业网实例ID
+	CloudName       string // 账户名称
+	Name            string // 名称
+	Status          string // 状态
+	ProtectionLevel string // 网段重叠保护级别
+	Description     string // 描述
+	CreateTime      string // 创建时间
+}
+
+// 云企业网已加载网络实例数据结构
+type CENChildInstanceRecord struct {
+	CENID                 string // 云企业网实例ID
+	ChildInstanceID       string // 网络实例ID（VPC/VBR/CCN）
+	ChildInstanceType     string // 网络实例类型（VPC/VBR/CCN）
+	ChildInstanceRegionID string // 网络实例所在区域
+	ChildInstanceOwnerID  string // 网络实例所属主账号 ID（跨账号加载时与 CEN 所属账号不同）
+	Status                string // 加载状态（Attached/Attaching/Detaching）
+	AttachTime            string // 加载时间
+}
+
+// 云企业网带宽包数据结构
+type CENBandwidthPackageRecord struct {
+	PackageID         string // 带宽包ID
+	CloudName         string // 账户名称
+	Name              string // 名称
+	CENIDs            string // 绑定的云企业网实例ID，多个以逗号分隔
+	Bandwidth         int64  // 带宽（Mbps）
+	GeographicRegionA string // 互通区域 A（china/asia-pacific 等）
+	GeographicRegionB string // 互通区域 B
+	Status            string // 状态（Idle/InUse）
+	BusinessStatus    string // 业务状态
+	ChargeType        string // 付费类型
+	ExpiredTime       string // 到期时间
+}
+
+// SaveVPNGatewayRecords 覆盖保存指定账户、区域下的全部 VPN 网关，已删除的网关会从表中删除
+func SaveVPNGatewayRecords(cloudName string, regionID string, records []VPNGatewayRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM vpn_gateways WHERE cloud_name = ? AND region_id = ?", cloudName, regionID); err != nil {
+		return fmt.Errorf("清理 VPN 网关记录失败 (账户=%s, 区域=%s): %w", cloudName, regionID, err)
+	}
+	for _, rec := range records {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO vpn_gateways
+             (vpn_gateway_id, cloud_name, region_id, name, vpc_id, vswitch_id, internet_ip, spec, status, ipsec_vpn, ssl_vpn, create_time)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			rec.VPNGatewayID, rec.CloudName, rec.RegionID, rec.Name, rec.VPCID, rec.VSwitchID, rec.InternetIP, rec.Spec, rec.Status,
+			rec.IPsecVPN, rec.SSLVPN, rec.CreateTime,
+		)
+		if err != nil {
+			return fmt.Errorf("插入 VPN 网关记录失败 (VPNGatewayID=%s): %w", rec.VPNGatewayID, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// SaveCustomerGatewayRecords 覆盖保存指定账户、区域下的全部用户网关，已删除的网关会从表中删除
+func SaveCustomerGatewayRecords(cloudName string, regionID string, records []CustomerGatewayRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM customer_gateways WHERE cloud_name = ? AND region_id = ?", cloudName, regionID); err != nil {
+		return fmt.Errorf("清理用户网关记录失败 (账户=%s, 区域=%s): %w", cloudName, regionID, err)
+	}
+	for _, rec := range records {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO customer_gateways
+             (customer_gateway_id, cloud_name, region_id, name, ip_address, asn, description, create_time)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+			rec.CustomerGatewayID, rec.CloudName, rec.RegionID, rec.Name, rec.IPAddress, rec.ASN, rec.Description, rec.CreateTime,
+		)
+		if err != nil {
+			return fmt.Errorf("插入用户网关记录失败 (CustomerGatewayID=%s): %w", rec.CustomerGatewayID, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// SaveVPNConnectionRecords 覆盖保存指定账户、区域下的全部 IPsec 连接，已删除的连接会从表中删除
+func SaveVPNConnectionRecords(cloudName string, regionID string, records []VPNConnectionRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM vpn_connections WHERE cloud_name = ? AND region_id = ?", cloudName, regionID); err != nil {
+		return fmt.Errorf("清理 IPsec 连接记录失败 (账户=%s, 区域=%s): %w", cloudName, regionID, err)
+	}
+	for _, rec := range records {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO vpn_connections
+             (vpn_connection_id, cloud_name, region_id, name, vpn_gateway_id, customer_gateway_id, local_subnet, remote_subnet, status, create_time)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			rec.VPNConnectionID, rec.CloudName, rec.RegionID, rec.Name, rec.VPNGatewayID, rec.CustomerGatewayID, rec.LocalSubnet,
+			rec.RemoteSubnet, rec.Status, rec.CreateTime,
+		)
+		if err != nil {
+			return fmt.Errorf("插入 IPsec 连接记录失败 (VPNConnectionID=%s): %w", rec.VPNConnectionID, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// SaveVBRRecords 覆盖保存指定账户、区域下的全部边界路由器，已删除的边界路由器会从表中删除
+func SaveVBRRecords(cloudName string, regionID string, records []VBRRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM vbrs WHERE cloud_name = ? AND region_id = ?", cloudName, regionID); err != nil {
+		return fmt.Errorf("清理边界路由器记录失败 (账户=%s, 区域=%s): %w", cloudName, regionID, err)
+	}
+	for _, rec := range records {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO vbrs
+             (vbr_id, cloud_name, region_id, name, physical_connection_id, vlan_id, local_gateway_ip, peer_gateway_ip, peering_subnet_mask, status, create_time)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			rec.VBRID, rec.CloudName, rec.RegionID, rec.Name, rec.PhysicalConnectionID, rec.VLANID, rec.LocalGatewayIP, rec.PeerGatewayIP,
+			rec.PeeringSubnetMask, rec.Status, rec.CreateTime,
+		)
+		if err != nil {
+			return fmt.Errorf("插入边界路由器记录失败 (VBRID=%s): %w", rec.VBRID, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// SavePhysicalConnectionRecords 覆盖保存指定账户、区域下的全部物理专线，已删除的专线会从表中删除
+func SavePhysicalConnectionRecords(cloudName string, regionID string, records []PhysicalConnectionRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM physical_connections WHERE cloud_name = ? AND region_id = ?", cloudName, regionID); err != nil {
+		return fmt.Errorf("清理物理专线记录失败 (账户=%s, 区域=%s): %w", cloudName, regionID, err)
+	}
+	for _, rec := range records {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO physical_connections
+             (physical_connection_id, cloud_name, region_id, name, access_point_id, line_operator, peer_location, bandwidth, port_type,
+              status, business_status, create_time)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			rec.PhysicalConnectionID, rec.CloudName, rec.RegionID, rec.Name, rec.AccessPointID, rec.LineOperator, rec.PeerLocation,
+			rec.Bandwidth, rec.PortType, rec.Status, rec.BusinessStatus, rec.CreateTime,
+		)
+		if err != nil {
+			return fmt.Errorf("插入物理专线记录失败 (PhysicalConnectionID=%s): %w", rec.PhysicalConnectionID, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// SaveCENRecords 覆盖保存指定账户下的全部云企业网实例，已删除实例加载的网络实例会一并删除
+func SaveCENRecords(cloudName string, records []CENRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM cen_instances WHERE cloud_name = ?", cloudName); err != nil {
+		return fmt.Errorf("清理云企业网记录失败 (账户=%s): %w", cloudName, err)
+	}
+	for _, rec := range records {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO cen_instances
+             (cen_id, cloud_name, name, status, protection_level, description, create_time)
+             VALUES (?, ?, ?, ?, ?, ?, ?)`,
+			rec.CENID, rec.CloudName, rec.Name, rec.Status, rec.ProtectionLevel, rec.Description, rec.CreateTime,
+		)
+		if err != nil {
+			return fmt.Errorf("插入云企业网记录失败 (CENID=%s): %w", rec.CENID, err)
+		}
+	}
+	// 已删除云企业网的网络实例不会再被同步覆盖，需一并清理
+	if err := deleteOrphans(tx, "SELECT cen_id FROM cen_instances", "cen_id", "cen_child_instances"); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// SaveCENChildInstances 覆盖保存指定云企业网已加载的网络实例
+func SaveCENChildInstances(cenID string, records []CENChildInstanceRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM cen_child_instances WHERE cen_id = ?", cenID); err != nil {
+		return fmt.Errorf("清理云企业网网络实例失败 (CENID=%s): %w", cenID, err)
+	}
+	for _, rec := range records {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO cen_child_instances
+             (cen_id, child_instance_id, child_instance_type, child_instance_region_id, child_instance_owner_id, status, attach_time)
+             VALUES (?, ?, ?, ?, ?, ?, ?)`,
+			cenID, rec.ChildInstanceID, rec.ChildInstanceType, rec.ChildInstanceRegionID, rec.ChildInstanceOwnerID, rec.Status, rec.AttachTime,
+		)
+		if err != nil {
+			return fmt.Errorf("插入云企业网网络实例记录失败 (ChildInstanceID=%s): %w", rec.ChildInstanceID, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// SaveCENBandwidthPackageRecords 覆盖保存指定账户下的全部云企业网带宽包，已释放的带宽包会从表中删除
+func SaveCENBandwidthPackageRecords(cloudName string, records []CENBandwidthPackageRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM cen_bandwidth_packages WHERE cloud_name = ?", cloudName); err != nil {
+		return fmt.Errorf("清理云企业网带宽包记录失败 (账户=%s): %w", cloudName, err)
+	}
+	for _, rec := range records {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO cen_bandwidth_packages
+             (package_id, cloud_name, name, cen_ids, bandwidth, geographic_region_a, geographic_region_b, status, business_status,
+              charge_type, expired_time)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			rec.PackageID, rec.CloudName, rec.Name, rec.CENIDs, rec.Bandwidth, rec.GeographicRegionA, rec.GeographicRegionB, rec.Status,
+			rec.BusinessStatus, rec.ChargeType, rec.ExpiredTime,
+		)
+		if err != nil {
+			return fmt.Errorf("插入云企业网带宽包记录失败 (PackageID=%s): %w", rec.PackageID, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// 查询所有 VPN 网关记录
+func ListVPNGatewayRecords() ([]VPNGatewayRecord, error) {
+	rows, err := db.Query(
+		`SELECT vpn_gateway_id, cloud_name, region_id, name, vpc_id, vswitch_id, internet_ip, spec, status, ipsec_vpn, ssl_vpn, create_time
+		 FROM vpn_gateways`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询 VPN 网关表失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []VPNGatewayRecord
+	for rows.Next() {
+		var rec VPNGatewayRecord
+		err := rows.Scan(&rec.VPNGatewayID, &rec.CloudName, &rec.RegionID, &rec.Name, &rec.VPCID, &rec.VSwitchID, &rec.InternetIP,
+			&rec.Spec, &rec.Status, &rec.IPsecVPN, &rec.SSLVPN, &rec.CreateTime)
+		if err != nil {
+			return nil, fmt.Errorf("读取 VPN 网关行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
+
+// 查询所有用户网关记录
+func ListCustomerGatewayRecords() ([]CustomerGatewayRecord, error) {
+	rows, err := db.Query(
+		`SELECT customer_gateway_id, cloud_name, region_id, name, ip_address, asn, description, create_time
+		 FROM customer_gateways`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询用户网关表失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []CustomerGatewayRecord
+	for rows.Next() {
+		var rec CustomerGatewayRecord
+		err := rows.Scan(&rec.CustomerGatewayID, &rec.CloudName, &rec.RegionID, &rec.Name, &rec.IPAddress, &rec.ASN, &rec.Description,
+			&rec.CreateTime)
+		if err != nil {
+			return nil, fmt.Errorf("读取用户网关行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
+
+// 查询所有 IPsec 连接记录
+func ListVPNConnectionRecords() ([]VPNConnectionRecord, error) {
+	rows, err := db.Query(
+		`SELECT vpn_connection_id, cloud_name, region_id, name, vpn_gateway_id, customer_gateway_id, local_subnet, remote_subnet, status, create_time
+		 FROM vpn_connections`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询 IPsec 连接表失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []VPNConnectionRecord
+	for rows.Next() {
+		var rec VPNConnectionRecord
+		err := rows.Scan(&rec.VPNConnectionID, &rec.CloudName, &rec.RegionID, &rec.Name, &rec.VPNGatewayID, &rec.CustomerGatewayID,
+			&rec.LocalSubnet, &rec.RemoteSubnet, &rec.Status, &rec.CreateTime)
+		if err != nil {
+			return nil, fmt.Errorf("读取 IPsec 连接行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
+
+// 查询所有边界路由器记录
+func ListVBRRecords() ([]VBRRecord, error) {
+	rows, err := db.Query(
+		`SELECT vbr_id, cloud_name, region_id, name, physical_connection_id, vlan_id, local_gateway_ip, peer_gateway_ip, peering_subnet_mask, status, create_time
+		 FROM vbrs`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询边界路由器表失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []VBRRecord
+	for rows.Next() {
+		var rec VBRRecord
+		err := rows.Scan(&rec.VBRID, &rec.CloudName, &rec.RegionID, &rec.Name, &rec.PhysicalConnectionID, &rec.VLANID, &rec.LocalGatewayIP,
+			&rec.PeerGatewayIP, &rec.PeeringSubnetMask, &rec.Status, &rec.CreateTime)
+		if err != nil {
+			return nil, fmt.Errorf("读取边界路由器行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
+
+// 查询所有物理专线记录
+func ListPhysicalConnectionRecords() ([]PhysicalConnectionRecord, error) {
+	rows, err := db.Query(
+		`SELECT physical_connection_id, cloud_name, region_id, name, access_point_id, line_operator, peer_location, bandwidth, port_type,
+		        status, business_status, create_time
+		 FROM physical_connections`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询物理专线表失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []PhysicalConnectionRecord
+	for rows.Next() {
+		var rec PhysicalConnectionRecord
+		err := rows.Scan(&rec.PhysicalConnectionID, &rec.CloudName, &rec.RegionID, &rec.Name, &rec.AccessPointID, &rec.LineOperator,
+			&rec.PeerLocation, &rec.Bandwidth, &rec.PortType, &rec.Status, &rec.BusinessStatus, &rec.CreateTime)
+		if err != nil {
+			return nil, fmt.Errorf("读取物理专线行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
+
+// 查询所有云企业网实例记录
+func ListCENRecords() ([]CENRecord, error) {
+	rows, err := db.Query(
+		`SELECT cen_id, cloud_name, name, status, protection_level, description, create_time FROM cen_instances`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询云企业网表失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []CENRecord
+	for rows.Next() {
+		var rec CENRecord
+		err := rows.Scan(&rec.CENID, &rec.CloudName, &rec.Name, &rec.Status, &rec.ProtectionLevel, &rec.Description, &rec.CreateTime)
+		if err != nil {
+			return nil, fmt.Errorf("读取云企业网行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
+
+// 查询所有云企业网已加载的网络实例，按云企业网实例ID分组返回
+func ListCENChildInstances() (map[string][]CENChildInstanceRecord, error) {
+	rows, err := db.Query(
+		`SELECT cen_id, child_instance_id, child_instance_type, child_instance_region_id, child_instance_owner_id, status, attach_time
+		 FROM cen_child_instances ORDER BY child_instance_type, child_instance_region_id`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询云企业网网络实例表失败: %w", err)
+	}
+	defer rows.Close()
+
+	results := map[string][]CENChildInstanceRecord{}
+	for rows.Next() {
+		var rec CENChildInstanceRecord
+		err := rows.Scan(&rec.CENID, &rec.ChildInstanceID, &rec.ChildInstanceType, &rec.ChildInstanceRegionID, &rec.ChildInstanceOwnerID,
+			&rec.Status, &rec.AttachTime)
+		if err != nil {
+			return nil, fmt.Errorf("读取云企业网网络实例行数据失败: %w", err)
+		}
+		results[rec.CENID] = append(results[rec.CENID], rec)
+	}
+	return results, nil
+}
+
+// 查询所有云企业网带宽包记录
+func ListCENBandwidthPackageRecords() ([]CENBandwidthPackageRecord, error) {
+	rows, err := db.Query(
+		`SELECT package_id, cloud_name, name, cen_ids, bandwidth, geographic_region_a, geographic_region_b, status, business_status,
+		        charge_type, expired_time
+		 FROM cen_bandwidth_packages`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询云企业网带宽包表失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []CENBandwidthPackageRecord
+	for rows.Next() {
+		var rec CENBandwidthPackageRecord
+		err := rows.Scan(&rec.PackageID, &rec.CloudName, &rec.Name, &rec.CENIDs, &rec.Bandwidth, &rec.GeographicRegionA,
+			&rec.GeographicRegionB, &rec.Status, &rec.BusinessStatus, &rec.ChargeType, &rec.ExpiredTime)
+		if err != nil {
+			return nil, fmt.Errorf("读取云企业网带宽包行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
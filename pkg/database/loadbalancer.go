@@ -0,0 +1,468 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// 负载均衡监听、服务器组及后端服务器相关表（CLB/ALB/NLB 共用）
+func initLoadBalancerTables() error {
+	// 监听表
+	_, err := db.Exec(`CREATE TABLE IF NOT EXISTS lb_listeners (
+		listener_id TEXT PRIMARY KEY,
+		lb_type TEXT,
+		lb_id TEXT,
+		listener_port INTEGER,
+		protocol TEXT,
+		backend_port INTEGER,
+		server_group_id TEXT,
+		status TEXT,
+		health_check TEXT,
+		certificate_id TEXT,
+		description TEXT
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 lb_listeners 表失败: %w", err)
+	}
+	// 服务器组表
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS lb_server_groups (
+		server_group_id TEXT PRIMARY KEY,
+		lb_type TEXT,
+		lb_id TEXT,
+		server_group_name TEXT,
+		protocol TEXT
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 lb_server_groups 表失败: %w", err)
+	}
+	// 后端服务器表
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS lb_backend_servers (
+		server_group_id TEXT,
+		server_id TEXT,
+		server_type TEXT,
+		server_ip TEXT,
+		port INTEGER,
+		weight INTEGER,
+		description TEXT
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 lb_backend_servers 表失败: %w", err)
+	}
+	_, err = db.Exec(`CREATE INDEX IF NOT EXISTS idx_lb_backend_servers_server ON lb_backend_servers (server_id);`)
+	if err != nil {
+		return fmt.Errorf("创建 lb_backend_servers 索引失败: %w", err)
+	}
+	// ALB/NLB 实例表（CLB 仍保存在 slb 表）
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS load_balancers (
+		lb_id TEXT PRIMARY KEY,
+		lb_type TEXT,
+		cloud_name TEXT,
+		lb_name TEXT,
+		dns_name TEXT,
+		address_type TEXT,
+		addresses TEXT,
+		zones TEXT,
+		vpc_id TEXT,
+		vswitch_id TEXT,
+		region_id TEXT,
+		lb_status TEXT,
+		edition TEXT,
+		create_time TEXT
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 load_balancers 表失败: %w", err)
+	}
+	// ALB 转发规则表
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS lb_rules (
+		rule_id TEXT PRIMARY KEY,
+		lb_id TEXT,
+		listener_id TEXT,
+		rule_name TEXT,
+		priority INTEGER,
+		conditions TEXT,
+		server_group_ids TEXT,
+		status TEXT
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 lb_rules 表失败: %w", err)
+	}
+	return nil
+}
+
+// 负载均衡类型
+const (
+	LBTypeCLB = "clb" // 传统型负载均衡（SLB）
+	LBTypeALB = "alb" // 应用型负载均衡
+	LBTypeNLB = "nlb" // 网络型负载均衡
+)
+
+// 负载均衡实例数据结构（ALB/NLB，CLB 查询时由 slb 表转换而来）
+type LoadBalancerRecord struct {
+	InstanceID       string // 实例ID
+	LBType           string // 负载均衡类型（clb/alb/nlb）
+	CloudName        string // 账户名称
+	LoadBalancerName string // 实例名称
+	DNSName          string // DNS 域名（CLB 无）
+	AddressType      string // 地址类型（Internet/Intranet）
+	Addresses        string // 服务地址，多个以逗号分隔
+	Zones            string // 可用区，多个以逗号分隔
+	VPCID            string // 专有网络ID
+	VSwitchID        string // 交换机ID，多个以逗号分隔
+	RegionID         string // 区域ID
+	Status           string // 状态
+	Edition          string // 版本（ALB 基础版/标准版/WAF 增强版）
+	CreateTime       string // 创建时间
+}
+
+// 转发规则数据结构（仅 ALB）
+type LBRuleRecord struct {
+	RuleID         string // 规则ID
+	LBID           string // 负载均衡实例ID
+	ListenerID     string // 监听ID
+	RuleName       string // 规则名称
+	Priority       int64  // 优先级，数值越小越优先
+	Conditions     string // 转发条件，如 Host=a.com; Path=/api/*
+	ServerGroupIDs string // 转发到的服务器组ID，多个以逗号分隔
+	Status         string // 状态
+}
+
+// 负载均衡监听数据结构
+type LBListenerRecord struct {
+	ListenerID    string // 监听ID（CLB 无监听ID，使用 实例ID-协议-端口 拼接）
+	LBType        string // 负载均衡类型（clb/alb/nlb）
+	LBID          string // 负载均衡实例ID
+	ListenerPort  int64  // 前端监听端口
+	Protocol      string // 监听协议
+	BackendPort   int64  // 后端端口（CLB 默认服务器组使用）
+	ServerGroupID string // 转发到的服务器组ID，ALB 默认动作可能有多个，以逗号分隔
+	Status        string // 状态
+	HealthCheck   string // 健康检查（on/off 或检查方式）
+	CertificateID string // HTTPS/TCPSSL/QUIC 监听的服务器证书ID，多个以逗号分隔
+	Description   string // 描述
+}
+
+// 服务器组数据结构
+type LBServerGroupRecord struct {
+	ServerGroupID   string
+	LBType          string
+	LBID            string
+	ServerGroupName string
+	Protocol        string
+}
+
+// 后端服务器数据结构
+type LBBackendServerRecord struct {
+	ServerGroupID string // 所属服务器组ID
+	ServerID      string // 后端实例ID（ECS/ENI/ECI 等）
+	ServerType    string // 后端类型
+	ServerIP      string // 后端 IP
+	Port          int64  // 后端端口，0 表示使用监听的后端端口
+	Weight        int64  // 权重
+	Description   string // 描述
+}
+
+// 某个后端服务器所挂载的负载均衡监听
+type LBBackendMappingRecord struct {
+	LBType        string
+	LBID          string
+	LBName        string
+	LBAddress     string
+	ListenerID    string
+	ListenerPort  int64
+	Protocol      string
+	ServerGroupID string
+	BackendPort   int64
+	Weight        int64
+}
+
+// CLB 默认服务器组没有ID，使用实例ID拼接一个固定ID
+func CLBDefaultServerGroupID(lbID string) string {
+	return lbID + "/default"
+}
+
+// SaveLBListeners 覆盖保存指定负载均衡实例的全部监听
+func SaveLBListeners(lbID string, listeners []LBListenerRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM lb_listeners WHERE lb_id = ?", lbID); err != nil {
+		return fmt.Errorf("清理监听失败 (LoadBalancerID=%s): %w", lbID, err)
+	}
+	for _, rec := range listeners {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO lb_listeners
+             (listener_id, lb_type, lb_id, listener_port, protocol, backend_port, server_group_id, status, health_check, certificate_id, description)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			rec.ListenerID, rec.LBType, lbID, rec.ListenerPort, rec.Protocol, rec.BackendPort, rec.ServerGroupID,
+			rec.Status, rec.HealthCheck, rec.CertificateID, rec.Description,
+		)
+		if err != nil {
+			return fmt.Errorf("插入监听记录失败 (ListenerID=%s): %w", rec.ListenerID, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// deleteReleasedLBDetails 删除已释放负载均衡实例（CLB/ALB/NLB）的监听、转发规则、服务器组及后端服务器
+func deleteReleasedLBDetails(tx *sql.Tx) error {
+	if err := deleteOrphans(tx, "SELECT lb_id FROM slb UNION SELECT lb_id FROM load_balancers", "lb_id", "lb_listeners", "lb_rules", "lb_server_groups"); err != nil {
+		return err
+	}
+	return deleteOrphans(tx, "SELECT server_group_id FROM lb_server_groups", "server_group_id", "lb_backend_servers")
+}
+
+// DeleteLBServerGroups 删除指定负载均衡实例下的全部服务器组及后端服务器，用于同步前清理已删除的服务器组
+func DeleteLBServerGroups(lbID string) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(
+		"DELETE FROM lb_backend_servers WHERE server_group_id IN (SELECT server_group_id FROM lb_server_groups WHERE lb_id = ?)",
+		lbID,
+	)
+	if err != nil {
+		return fmt.Errorf("清理后端服务器失败 (LoadBalancerID=%s): %w", lbID, err)
+	}
+	if _, err := tx.Exec("DELETE FROM lb_server_groups WHERE lb_id = ?", lbID); err != nil {
+		return fmt.Errorf("清理服务器组失败 (LoadBalancerID=%s): %w", lbID, err)
+	}
+	return tx.Commit()
+}
+
+// SaveLBServerGroup 保存服务器组，并覆盖保存其后端服务器
+func SaveLBServerGroup(group LBServerGroupRecord, servers []LBBackendServerRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(
+		`INSERT OR REPLACE INTO lb_server_groups (server_group_id, lb_type, lb_id, server_group_name, protocol)
+         VALUES (?, ?, ?, ?, ?)`,
+		group.ServerGroupID, group.LBType, group.LBID, group.ServerGroupName, group.Protocol,
+	)
+	if err != nil {
+		return fmt.Errorf("插入服务器组记录失败 (ServerGroupID=%s): %w", group.ServerGroupID, err)
+	}
+	if _, err := tx.Exec("DELETE FROM lb_backend_servers WHERE server_group_id = ?", group.ServerGroupID); err != nil {
+		return fmt.Errorf("清理后端服务器失败 (ServerGroupID=%s): %w", group.ServerGroupID, err)
+	}
+	for _, rec := range servers {
+		_, err := tx.Exec(
+			`INSERT INTO lb_backend_servers (server_group_id, server_id, server_type, server_ip, port, weight, description)
+             VALUES (?, ?, ?, ?, ?, ?, ?)`,
+			group.ServerGroupID, rec.ServerID, rec.ServerType, rec.ServerIP, rec.Port, rec.Weight, rec.Description,
+		)
+		if err != nil {
+			return fmt.Errorf("插入后端服务器失败 (ServerGroupID=%s, ServerID=%s): %w", group.ServerGroupID, rec.ServerID, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// 查询指定负载均衡实例的全部监听
+func ListLBListeners(lbID string) ([]LBListenerRecord, error) {
+	rows, err := db.Query(
+		`SELECT listener_id, lb_type, lb_id, listener_port, protocol, backend_port, server_group_id, status, health_check, certificate_id, description
+		 FROM lb_listeners WHERE lb_id = ? ORDER BY listener_port`,
+		lbID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询监听失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []LBListenerRecord
+	for rows.Next() {
+		var rec LBListenerRecord
+		err := rows.Scan(&rec.ListenerID, &rec.LBType, &rec.LBID, &rec.ListenerPort, &rec.Protocol, &rec.BackendPort,
+			&rec.ServerGroupID, &rec.Status, &rec.HealthCheck, &rec.CertificateID, &rec.Description)
+		if err != nil {
+			return nil, fmt.Errorf("读取监听行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
+
+// 查询指定服务器组的后端服务器
+func ListLBBackendServers(serverGroupID string) ([]LBBackendServerRecord, error) {
+	rows, err := db.Query(
+		`SELECT server_group_id, server_id, server_type, server_ip, port, weight, description
+		 FROM lb_backend_servers WHERE server_group_id = ?`,
+		serverGroupID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询后端服务器失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []LBBackendServerRecord
+	for rows.Next() {
+		var rec LBBackendServerRecord
+		err := rows.Scan(&rec.ServerGroupID, &rec.ServerID, &rec.ServerType, &rec.ServerIP, &rec.Port, &rec.Weight, &rec.Description)
+		if err != nil {
+			return nil, fmt.Errorf("读取后端服务器行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
+
+// ListLBMappingsByServer 反查某个后端实例（如 ECS）挂载在哪些负载均衡监听下
+// 服务器组可能被监听默认动作引用，也可能被 ALB 转发规则引用
+func ListLBMappingsByServer(serverID string) ([]LBBackendMappingRecord, error) {
+	rows, err := db.Query(
+		`SELECT l.lb_type, l.lb_id, COALESCE(s.lb_name, a.lb_name, ''), COALESCE(s.ip_address, a.dns_name, ''), l.listener_id, l.listener_port, l.protocol,
+		        b.server_group_id, CASE WHEN b.port > 0 THEN b.port ELSE l.backend_port END, b.weight
+		 FROM lb_backend_servers b
+		 JOIN lb_listeners l ON (',' || l.server_group_id || ',') LIKE ('%,' || b.server_group_id || ',%')
+		    OR l.listener_id IN (SELECT r.listener_id FROM lb_rules r
+		                         WHERE (',' || r.server_group_ids || ',') LIKE ('%,' || b.server_group_id || ',%'))
+		 LEFT JOIN slb s ON s.lb_id = l.lb_id
+		 LEFT JOIN load_balancers a ON a.lb_id = l.lb_id
+		 WHERE b.server_id = ?
+		 GROUP BY l.listener_id, b.server_group_id
+		 ORDER BY l.lb_id, l.listener_port`,
+		serverID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询负载均衡反查数据失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []LBBackendMappingRecord
+	for rows.Next() {
+		var rec LBBackendMappingRecord
+		err := rows.Scan(&rec.LBType, &rec.LBID, &rec.LBName, &rec.LBAddress, &rec.ListenerID, &rec.ListenerPort, &rec.Protocol,
+			&rec.ServerGroupID, &rec.BackendPort, &rec.Weight)
+		if err != nil {
+			return nil, fmt.Errorf("读取负载均衡反查行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
+
+// SaveLoadBalancerRecords 覆盖保存指定账户、区域下某一类型（ALB/NLB）的全部负载均衡实例，已释放的实例及其监听、服务器组会从表中删除
+func SaveLoadBalancerRecords(cloudName string, regionID string, lbType string, records []LoadBalancerRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM load_balancers WHERE cloud_name = ? AND region_id = ? AND lb_type = ?", cloudName, regionID, lbType); err != nil {
+		return fmt.Errorf("清理负载均衡记录失败 (账户=%s, 区域=%s, 类型=%s): %w", cloudName, regionID, lbType, err)
+	}
+	for _, rec := range records {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO load_balancers
+             (lb_id, lb_type, cloud_name, lb_name, dns_name, address_type, addresses, zones, vpc_id, vswitch_id, region_id, lb_status, edition, create_time)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			rec.InstanceID, rec.LBType, rec.CloudName, rec.LoadBalancerName, rec.DNSName, rec.AddressType, rec.Addresses, rec.Zones,
+			rec.VPCID, rec.VSwitchID, rec.RegionID, rec.Status, rec.Edition, rec.CreateTime,
+		)
+		if err != nil {
+			return fmt.Errorf("插入负载均衡记录失败 (LoadBalancerID=%s): %w", rec.InstanceID, err)
+		}
+	}
+	if err := deleteReleasedLBDetails(tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// 查询所有 ALB/NLB 记录
+func ListLoadBalancerRecords() ([]LoadBalancerRecord, error) {
+	return queryLoadBalancerRecords(
+		`SELECT lb_id, lb_type, cloud_name, lb_name, dns_name, address_type, addresses, zones, vpc_id, vswitch_id, region_id, lb_status, edition, create_time
+		 FROM load_balancers`,
+	)
+}
+
+// 查询全部类型的负载均衡记录，CLB 由 slb 表转换为统一结构
+func ListAllLoadBalancerRecords() ([]LoadBalancerRecord, error) {
+	return queryLoadBalancerRecords(
+		`SELECT lb_id, 'clb', cloud_name, lb_name, '', network_type, ip_address, '', IFNULL(vpc_id, ''), IFNULL(vswitch_id, ''), region_id, lb_status, '', ''
+		 FROM slb
+		 UNION ALL
+		 SELECT lb_id, lb_type, cloud_name, lb_name, dns_name, address_type, addresses, zones, vpc_id, vswitch_id, region_id, lb_status, edition, create_time
+		 FROM load_balancers`,
+	)
+}
+
+func queryLoadBalancerRecords(query string) ([]LoadBalancerRecord, error) {
+	rows, err := db.Query(query)
+	if err != nil {
+		return nil, fmt.Errorf("查询负载均衡表失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []LoadBalancerRecord
+	for rows.Next() {
+		var rec LoadBalancerRecord
+		err := rows.Scan(&rec.InstanceID, &rec.LBType, &rec.CloudName, &rec.LoadBalancerName, &rec.DNSName, &rec.AddressType,
+			&rec.Addresses, &rec.Zones, &rec.VPCID, &rec.VSwitchID, &rec.RegionID, &rec.Status, &rec.Edition, &rec.CreateTime)
+		if err != nil {
+			return nil, fmt.Errorf("读取负载均衡行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
+
+// SaveLBRules 覆盖保存指定负载均衡实例的全部转发规则
+func SaveLBRules(lbID string, rules []LBRuleRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM lb_rules WHERE lb_id = ?", lbID); err != nil {
+		return fmt.Errorf("清理转发规则失败 (LoadBalancerID=%s): %w", lbID, err)
+	}
+	for _, rec := range rules {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO lb_rules (rule_id, lb_id, listener_id, rule_name, priority, conditions, server_group_ids, status)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+			rec.RuleID, lbID, rec.ListenerID, rec.RuleName, rec.Priority, rec.Conditions, rec.ServerGroupIDs, rec.Status,
+		)
+		if err != nil {
+			return fmt.Errorf("插入转发规则失败 (RuleID=%s): %w", rec.RuleID, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// 查询指定监听的转发规则，按优先级排序
+func ListLBRules(listenerID string) ([]LBRuleRecord, error) {
+	rows, err := db.Query(
+		`SELECT rule_id, lb_id, listener_id, rule_name, priority, conditions, server_group_ids, status
+		 FROM lb_rules WHERE listener_id = ? ORDER BY priority`,
+		listenerID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询转发规则失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []LBRuleRecord
+	for rows.Next() {
+		var rec LBRuleRecord
+		err := rows.Scan(&rec.RuleID, &rec.LBID, &rec.ListenerID, &rec.RuleName, &rec.Priority, &rec.Conditions, &rec.ServerGroupIDs, &rec.Status)
+		if err != nil {
+			return nil, fmt.Errorf("读取转发规则行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
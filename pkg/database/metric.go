@@ -0,0 +1,110 @@
+package database
+
+import (
+	"fmt"
+)
+
+// 云监控指标汇总表：按资源和指标保存采集窗口内的平均值与峰值
+func initMetricTables() error {
+	_, err := db.Exec(`CREATE TABLE IF NOT EXISTS resource_metrics (
+		resource_type TEXT,
+		instance_id TEXT,
+		metric TEXT,
+		cloud_name TEXT,
+		region_id TEXT,
+		average REAL,
+		maximum REAL,
+		samples INTEGER,
+		window_days INTEGER,
+		collect_time TEXT,
+		PRIMARY KEY (resource_type, instance_id, metric)
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 resource_metrics 表失败: %w", err)
+	}
+	return nil
+}
+
+// 采集指标的资源类型
+const (
+	ResourceTypeECS     = "ecs"
+	ResourceTypeRDS     = "rds"
+	ResourceTypeRedis   = "redis"
+	ResourceTypePolarDB = "polardb"
+)
+
+// 汇总的指标
+const (
+	MetricCPU        = "cpu"        // CPU 使用率（%）
+	MetricMemory     = "memory"     // 内存使用率（%）
+	MetricConnection = "connection" // 连接数使用率（%）
+)
+
+// 资源指标汇总数据结构
+type ResourceMetricRecord struct {
+	ResourceType string  // 资源类型（ecs/rds/redis/polardb）
+	InstanceID   string  // 实例ID，PolarDB 为集群ID
+	Metric       string  // 指标（cpu/memory/connection）
+	CloudName    string  // 账户名称
+	RegionID     string  // 区域ID
+	Average      float64 // 窗口内平均值
+	Maximum      float64 // 窗口内峰值
+	Samples      int64   // 采样点数
+	WindowDays   int64   // 采集窗口（天）
+	CollectTime  string  // 采集时间
+}
+
+// SaveResourceMetricRecords 覆盖保存指定账户、区域下的全部指标汇总，已释放或不再上报的实例会从表中删除
+func SaveResourceMetricRecords(cloudName string, regionID string, records []ResourceMetricRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM resource_metrics WHERE cloud_name = ? AND region_id = ?", cloudName, regionID); err != nil {
+		return fmt.Errorf("清理资源指标记录失败 (账户=%s, 区域=%s): %w", cloudName, regionID, err)
+	}
+	for _, rec := range records {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO resource_metrics
+             (resource_type, instance_id, metric, cloud_name, region_id, average, maximum, samples, window_days, collect_time)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			rec.ResourceType, rec.InstanceID, rec.Metric, rec.CloudName, rec.RegionID, rec.Average, rec.Maximum, rec.Samples, rec.WindowDays,
+			rec.CollectTime,
+		)
+		if err != nil {
+			return fmt.Errorf("插入资源指标记录失败 (InstanceID=%s, Metric=%s): %w", rec.InstanceID, rec.Metric, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// 查询所有资源指标汇总，按资源类型及实例ID分组返回
+func ListResourceMetrics() (map[string]map[string]ResourceMetricRecord, error) {
+	rows, err := db.Query(
+		`SELECT resource_type, instance_id, metric, cloud_name, region_id, average, maximum, samples, window_days, collect_time
+		 FROM resource_metrics`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询资源指标表失败: %w", err)
+	}
+	defer rows.Close()
+
+	// 资源类型/实例ID -> 指标 -> 记录
+	results := map[string]map[string]ResourceMetricRecord{}
+	for rows.Next() {
+		var rec ResourceMetricRecord
+		err := rows.Scan(&rec.ResourceType, &rec.InstanceID, &rec.Metric, &rec.CloudName, &rec.RegionID, &rec.Average, &rec.Maximum,
+			&rec.Samples, &rec.WindowDays, &rec.CollectTime)
+		if err != nil {
+			return nil, fmt.Errorf("读取资源指标行数据失败: %w", err)
+		}
+		key := rec.ResourceType + "/" + rec.InstanceID
+		if results[key] == nil {
+			results[key] = map[string]ResourceMetricRecord{}
+		}
+		results[key][rec.Metric] = rec
+	}
+	return results, nil
+}
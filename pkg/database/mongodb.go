@@ -0,0 +1,193 @@
+package database
+
+import (
+	"fmt"
+)
+
+// MongoDB 实例及连接地址表
+func initMongoDBTables() error {
+	// 实例表
+	_, err := db.Exec(`CREATE TABLE IF NOT EXISTS mongodb (
+		instance_id TEXT PRIMARY KEY,
+		cloud_name TEXT,
+		description TEXT,
+		instance_type TEXT,
+		engine_version TEXT,
+		instance_class TEXT,
+		storage_size INTEGER,
+		storage_type TEXT,
+		status TEXT,
+		region_id TEXT,
+		zone_id TEXT,
+		vpc_id TEXT,
+		vswitch_id TEXT,
+		connection_string TEXT,
+		create_time TEXT
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 mongodb 表失败: %w", err)
+	}
+	// 连接地址表：副本集为各角色地址，分片集群为 Mongos/Shard/ConfigServer 节点地址
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS mongodb_endpoints (
+		instance_id TEXT,
+		node_id TEXT,
+		node_type TEXT,
+		role TEXT,
+		connection_string TEXT,
+		port TEXT,
+		ip_address TEXT,
+		network_type TEXT,
+		vpc_id TEXT,
+		vswitch_id TEXT,
+		expired_time TEXT
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 mongodb_endpoints 表失败: %w", err)
+	}
+	_, err = db.Exec(`CREATE INDEX IF NOT EXISTS idx_mongodb_endpoints_instance ON mongodb_endpoints (instance_id);`)
+	if err != nil {
+		return fmt.Errorf("创建 mongodb_endpoints 索引失败: %w", err)
+	}
+	return nil
+}
+
+// MongoDB 实例数据结构
+type MongoDBRecord struct {
+	InstanceID       string // 实例ID
+	CloudName        string // 账户名称
+	Description      string // 实例名称
+	InstanceType     string // 实例类型（replicate 副本集 / sharding 分片集群）
+	EngineVersion    string // 引擎版本
+	InstanceClass    string // 实例规格
+	StorageSize      int64  // 存储空间（GB）
+	StorageType      string // 存储类型
+	Status           string // 状态
+	RegionID         string // 区域ID
+	ZoneID           string // 可用区
+	VPCID            string // 专有网络ID
+	VSwitchID        string // 交换机ID
+	ConnectionString string // 全部连接地址，逗号分隔，便于搜索
+	CreateTime       string // 创建时间
+}
+
+// MongoDB 连接地址数据结构
+type MongoDBEndpointRecord struct {
+	InstanceID       string // 实例ID
+	NodeID           string // 节点ID（副本集为空）
+	NodeType         string // 节点类型（mongos/shard/configserver，副本集为 replicate）
+	Role             string // 角色（Primary/Secondary/ReadOnly 等）
+	ConnectionString string // 连接地址
+	Port             string // 端口
+	IPAddress        string // IP 地址
+	NetworkType      string // 网络类型（VPC/Classic/Public）
+	VPCID            string // 专有网络ID
+	VSwitchID        string // 交换机ID
+	ExpiredTime      string // 经典网络地址保留到期时间
+}
+
+// SaveMongoDBRecords 覆盖保存指定账户、区域下的全部 MongoDB 实例，已释放的实例及其连接地址会从表中删除
+func SaveMongoDBRecords(cloudName string, regionID string, records []MongoDBRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM mongodb WHERE cloud_name = ? AND region_id = ?", cloudName, regionID); err != nil {
+		return fmt.Errorf("清理 MongoDB 记录失败 (账户=%s, 区域=%s): %w", cloudName, regionID, err)
+	}
+	for _, rec := range records {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO mongodb
+             (instance_id, cloud_name, description, instance_type, engine_version, instance_class, storage_size, storage_type, status,
+              region_id, zone_id, vpc_id, vswitch_id, connection_string, create_time)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			rec.InstanceID, rec.CloudName, rec.Description, rec.InstanceType, rec.EngineVersion, rec.InstanceClass, rec.StorageSize,
+			rec.StorageType, rec.Status, rec.RegionID, rec.ZoneID, rec.VPCID, rec.VSwitchID, rec.ConnectionString, rec.CreateTime,
+		)
+		if err != nil {
+			return fmt.Errorf("插入 MongoDB 记录失败 (InstanceID=%s): %w", rec.InstanceID, err)
+		}
+	}
+	// 已释放实例的连接地址不会再被同步覆盖，需一并清理
+	if err := deleteOrphans(tx, "SELECT instance_id FROM mongodb", "instance_id", "mongodb_endpoints"); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// 查询所有 MongoDB 记录
+func ListMongoDBRecords() ([]MongoDBRecord, error) {
+	rows, err := db.Query(
+		`SELECT instance_id, cloud_name, description, instance_type, engine_version, instance_class, storage_size, storage_type, status,
+		        region_id, zone_id, vpc_id, vswitch_id, connection_string, create_time
+		 FROM mongodb`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询 MongoDB 表失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []MongoDBRecord
+	for rows.Next() {
+		var rec MongoDBRecord
+		err := rows.Scan(&rec.InstanceID, &rec.CloudName, &rec.Description, &rec.InstanceType, &rec.EngineVersion, &rec.InstanceClass,
+			&rec.StorageSize, &rec.StorageType, &rec.Status, &rec.RegionID, &rec.ZoneID, &rec.VPCID, &rec.VSwitchID,
+			&rec.ConnectionString, &rec.CreateTime)
+		if err != nil {
+			return nil, fmt.Errorf("读取 MongoDB 行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
+
+// SaveMongoDBEndpoints 覆盖保存指定 MongoDB 实例的连接地址
+func SaveMongoDBEndpoints(instanceID string, endpoints []MongoDBEndpointRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM mongodb_endpoints WHERE instance_id = ?", instanceID); err != nil {
+		return fmt.Errorf("清理 MongoDB 连接地址失败 (InstanceID=%s): %w", instanceID, err)
+	}
+	for _, rec := range endpoints {
+		_, err := tx.Exec(
+			`INSERT INTO mongodb_endpoints
+             (instance_id, node_id, node_type, role, connection_string, port, ip_address, network_type, vpc_id, vswitch_id, expired_time)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			instanceID, rec.NodeID, rec.NodeType, rec.Role, rec.ConnectionString, rec.Port, rec.IPAddress, rec.NetworkType,
+			rec.VPCID, rec.VSwitchID, rec.ExpiredTime,
+		)
+		if err != nil {
+			return fmt.Errorf("插入 MongoDB 连接地址记录失败 (InstanceID=%s): %w", instanceID, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// 查询所有 MongoDB 连接地址记录，按实例ID分组返回
+func ListMongoDBEndpoints() (map[string][]MongoDBEndpointRecord, error) {
+	rows, err := db.Query(
+		`SELECT instance_id, node_id, node_type, role, connection_string, port, ip_address, network_type, vpc_id, vswitch_id, expired_time
+		 FROM mongodb_endpoints ORDER BY instance_id, node_type, node_id`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询 MongoDB 连接地址表失败: %w", err)
+	}
+	defer rows.Close()
+
+	results := map[string][]MongoDBEndpointRecord{}
+	for rows.Next() {
+		var rec MongoDBEndpointRecord
+		err := rows.Scan(&rec.InstanceID, &rec.NodeID, &rec.NodeType, &rec.Role, &rec.ConnectionString, &rec.Port, &rec.IPAddress,
+			&rec.NetworkType, &rec.VPCID, &rec.VSwitchID, &rec.ExpiredTime)
+		if err != nil {
+			return nil, fmt.Errorf("读取 MongoDB 连接地址行数据失败: %w", err)
+		}
+		results[rec.InstanceID] = append(results[rec.InstanceID], rec)
+	}
+	return results, nil
+}
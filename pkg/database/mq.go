@@ -0,0 +1,174 @@
+package database
+
+import (
+	"fmt"
+)
+
+// 消息队列实例及 Topic/Group 表（Kafka、RocketMQ、RabbitMQ 共用）
+func initMQTables() error {
+	// 实例表
+	_, err := db.Exec(`CREATE TABLE IF NOT EXISTS mq_instances (
+		instance_id TEXT PRIMARY KEY,
+		mq_type TEXT,
+		cloud_name TEXT,
+		instance_name TEXT,
+		status TEXT,
+		spec TEXT,
+		region_id TEXT,
+		zone_id TEXT,
+		vpc_id TEXT,
+		vswitch_id TEXT,
+		endpoints TEXT,
+		create_time TEXT
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 mq_instances 表失败: %w", err)
+	}
+	// Topic/Group 表
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS mq_resources (
+		instance_id TEXT,
+		resource_type TEXT,
+		name TEXT,
+		detail TEXT,
+		remark TEXT,
+		PRIMARY KEY (instance_id, resource_type, name)
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 mq_resources 表失败: %w", err)
+	}
+	return nil
+}
+
+// 消息队列类型
+const (
+	MQTypeKafka    = "kafka"    // 云消息队列 Kafka 版
+	MQTypeRocketMQ = "rocketmq" // 云消息队列 RocketMQ 版（4.x，ons）
+	MQTypeAMQP     = "amqp"     // 云消息队列 RabbitMQ 版
+)
+
+// 消息队列实例数据结构
+type MQInstanceRecord struct {
+	InstanceID   string // 实例ID
+	MQType       string // 消息队列类型（kafka/rocketmq/amqp）
+	CloudName    string // 账户名称
+	InstanceName string // 实例名称
+	Status       string // 状态
+	Spec         string // 规格（Kafka 规格类型、RocketMQ 实例类型、RabbitMQ 实例类型）
+	RegionID     string // 区域ID
+	ZoneID       string // 可用区
+	VPCID        string // 专有网络ID
+	VSwitchID    string // 交换机ID
+	Endpoints    string // 接入点，多个以逗号分隔
+	CreateTime   string // 创建时间
+}
+
+// 消息队列 Topic/Group 数据结构
+type MQResourceRecord struct {
+	InstanceID   string // 实例ID
+	ResourceType string // 资源类型（topic/group）
+	Name         string // Topic 名称或 Group ID
+	Detail       string // 附加信息（Kafka 分区数、RocketMQ 消息类型/Group 协议）
+	Remark       string // 备注
+}
+
+// SaveMQInstanceRecords 覆盖保存指定账户、区域下某一类型的全部消息队列实例，已释放的实例及其 Topic、Group 会从表中删除
+func SaveMQInstanceRecords(cloudName string, regionID string, mqType string, records []MQInstanceRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM mq_instances WHERE cloud_name = ? AND region_id = ? AND mq_type = ?", cloudName, regionID, mqType); err != nil {
+		return fmt.Errorf("清理消息队列记录失败 (账户=%s, 区域=%s, 类型=%s): %w", cloudName, regionID, mqType, err)
+	}
+	for _, rec := range records {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO mq_instances
+             (instance_id, mq_type, cloud_name, instance_name, status, spec, region_id, zone_id, vpc_id, vswitch_id, endpoints, create_time)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			rec.InstanceID, rec.MQType, rec.CloudName, rec.InstanceName, rec.Status, rec.Spec, rec.RegionID, rec.ZoneID,
+			rec.VPCID, rec.VSwitchID, rec.Endpoints, rec.CreateTime,
+		)
+		if err != nil {
+			return fmt.Errorf("插入消息队列记录失败 (InstanceID=%s): %w", rec.InstanceID, err)
+		}
+	}
+	// 已释放实例的 Topic、Group 等资源不会再被同步覆盖，需一并清理
+	if err := deleteOrphans(tx, "SELECT instance_id FROM mq_instances", "instance_id", "mq_resources"); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// 查询所有消息队列实例记录
+func ListMQInstanceRecords() ([]MQInstanceRecord, error) {
+	rows, err := db.Query(
+		`SELECT instance_id, mq_type, cloud_name, instance_name, status, spec, region_id, zone_id, vpc_id, vswitch_id, endpoints, create_time
+		 FROM mq_instances`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询消息队列表失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []MQInstanceRecord
+	for rows.Next() {
+		var rec MQInstanceRecord
+		err := rows.Scan(&rec.InstanceID, &rec.MQType, &rec.CloudName, &rec.InstanceName, &rec.Status, &rec.Spec, &rec.RegionID,
+			&rec.ZoneID, &rec.VPCID, &rec.VSwitchID, &rec.Endpoints, &rec.CreateTime)
+		if err != nil {
+			return nil, fmt.Errorf("读取消息队列行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
+
+// SaveMQResources 覆盖保存指定消息队列实例的 Topic/Group
+func SaveMQResources(instanceID string, resources []MQResourceRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM mq_resources WHERE instance_id = ?", instanceID); err != nil {
+		return fmt.Errorf("清理消息队列 Topic/Group 失败 (InstanceID=%s): %w", instanceID, err)
+	}
+	for _, rec := range resources {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO mq_resources (instance_id, resource_type, name, detail, remark)
+             VALUES (?, ?, ?, ?, ?)`,
+			instanceID, rec.ResourceType, rec.Name, rec.Detail, rec.Remark,
+		)
+		if err != nil {
+			return fmt.Errorf("插入消息队列 %s 记录失败 (InstanceID=%s, Name=%s): %w", rec.ResourceType, instanceID, rec.Name, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// 查询消息队列 Topic/Group 记录，instanceID 为空时返回全部实例的记录
+func ListMQResources(instanceID string) ([]MQResourceRecord, error) {
+	rows, err := db.Query(
+		`SELECT instance_id, resource_type, name, detail, remark
+		 FROM mq_resources WHERE ? = '' OR instance_id = ?
+		 ORDER BY instance_id, resource_type DESC, name`,
+		instanceID, instanceID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询消息队列 Topic/Group 表失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []MQResourceRecord
+	for rows.Next() {
+		var rec MQResourceRecord
+		if err := rows.Scan(&rec.InstanceID, &rec.ResourceType, &rec.Name, &rec.Detail, &rec.Remark); err != nil {
+			return nil, fmt.Errorf("读取消息队列 Topic/Group 行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
@@ -0,0 +1,560 @@
+package database
+
+import (
+	"fmt"
+)
+
+// 创建 VPC、交换机、路由表及 NAT 网关相关表（如不存在）
+func initNetworkTables() error {
+	// VPC 信息表
+	_, err := db.Exec(`CREATE TABLE IF NOT EXISTS vpcs (
+		vpc_id TEXT PRIMARY KEY,
+		cloud_name TEXT,
+		vpc_name TEXT,
+		region_id TEXT,
+		cidr_block TEXT,
+		secondary_cidr_blocks TEXT,
+		ipv6_cidr_block TEXT,
+		status TEXT,
+		is_default INTEGER,
+		description TEXT,
+		remarks TEXT
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 vpcs 表失败: %w", err)
+	}
+	// 交换机信息表
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS vswitches (
+		vswitch_id TEXT PRIMARY KEY,
+		cloud_name TEXT,
+		vswitch_name TEXT,
+		vpc_id TEXT,
+		region_id TEXT,
+		zone_id TEXT,
+		cidr_block TEXT,
+		available_ip_count INTEGER,
+		status TEXT,
+		description TEXT,
+		remarks TEXT
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 vswitches 表失败: %w", err)
+	}
+	// 路由表信息表
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS route_tables (
+		route_table_id TEXT PRIMARY KEY,
+		cloud_name TEXT,
+		route_table_name TEXT,
+		vpc_id TEXT,
+		region_id TEXT,
+		route_table_type TEXT,
+		vswitch_ids TEXT,
+		description TEXT
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 route_tables 表失败: %w", err)
+	}
+	// 路由条目表
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS route_entries (
+		route_table_id TEXT,
+		route_entry_id TEXT,
+		route_entry_name TEXT,
+		destination_cidr_block TEXT,
+		next_hop_type TEXT,
+		next_hop_id TEXT,
+		entry_type TEXT,
+		status TEXT,
+		description TEXT
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 route_entries 表失败: %w", err)
+	}
+	// NAT 网关信息表
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS nat_gateways (
+		nat_gateway_id TEXT PRIMARY KEY,
+		cloud_name TEXT,
+		nat_gateway_name TEXT,
+		vpc_id TEXT,
+		region_id TEXT,
+		nat_type TEXT,
+		network_type TEXT,
+		spec TEXT,
+		status TEXT,
+		ip_addresses TEXT,
+		description TEXT,
+		remarks TEXT
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 nat_gateways 表失败: %w", err)
+	}
+	// NAT 网关 SNAT 条目表
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS nat_snat_entries (
+		snat_entry_id TEXT PRIMARY KEY,
+		nat_gateway_id TEXT,
+		snat_table_id TEXT,
+		snat_entry_name TEXT,
+		source_cidr TEXT,
+		source_vswitch_id TEXT,
+		snat_ip TEXT,
+		status TEXT
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 nat_snat_entries 表失败: %w", err)
+	}
+	// NAT 网关 DNAT 条目表
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS nat_dnat_entries (
+		forward_entry_id TEXT PRIMARY KEY,
+		nat_gateway_id TEXT,
+		forward_table_id TEXT,
+		forward_entry_name TEXT,
+		external_ip TEXT,
+		external_port TEXT,
+		internal_ip TEXT,
+		internal_port TEXT,
+		ip_protocol TEXT,
+		status TEXT
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 nat_dnat_entries 表失败: %w", err)
+	}
+	return nil
+}
+
+// VPC 数据结构
+type VPCRecord struct {
+	VPCID               string // 专有网络ID
+	CloudName           string // 账户名称
+	VPCName             string // 名称
+	RegionID            string // 区域ID
+	CidrBlock           string // 主网段
+	SecondaryCidrBlocks string // 附加网段(逗号分隔)
+	Ipv6CidrBlock       string // IPv6 网段
+	Status              string // 状态
+	IsDefault           bool   // 是否默认 VPC
+	Description         string // 描述
+}
+
+// 交换机数据结构
+type VSwitchRecord struct {
+	VSwitchID        string // 交换机ID
+	CloudName        string // 账户名称
+	VSwitchName      string // 名称
+	VPCID            string // 所属专有网络ID
+	RegionID         string // 区域ID
+	ZoneID           string // 可用区
+	CidrBlock        string // 网段
+	AvailableIPCount int64  // 可用 IP 数
+	Status           string // 状态
+	Description      string // 描述
+}
+
+// 路由表数据结构
+type RouteTableRecord struct {
+	RouteTableID   string // 路由表ID
+	CloudName      string // 账户名称
+	RouteTableName string // 名称
+	VPCID          string // 所属专有网络ID
+	RegionID       string // 区域ID
+	RouteTableType string // 类型（System/Custom）
+	VSwitchIDs     string // 绑定的交换机(逗号分隔)
+	Description    string // 描述
+}
+
+// 路由条目数据结构
+type RouteEntryRecord struct {
+	RouteTableID         string // 所属路由表ID
+	RouteEntryID         string // 路由条目ID
+	RouteEntryName       string // 名称
+	DestinationCidrBlock string // 目标网段
+	NextHopType          string // 下一跳类型
+	NextHopID            string // 下一跳实例ID
+	EntryType            string // 条目类型（System/Custom/BGP）
+	Status               string // 状态
+	Description          string // 描述
+}
+
+// NAT 网关数据结构
+type NatGatewayRecord struct {
+	NatGatewayID   string // NAT 网关ID
+	CloudName      string // 账户名称
+	NatGatewayName string // 名称
+	VPCID          string // 所属专有网络ID
+	RegionID       string // 区域ID
+	NatType        string // NAT 类型
+	NetworkType    string // 网络类型（internet/intranet）
+	Spec           string // 规格
+	Status         string // 状态
+	IPAddresses    string // 绑定的公网 IP(逗号分隔)
+	Description    string // 描述
+}
+
+// SNAT 条目数据结构
+type SnatEntryRecord struct {
+	SnatEntryID     string
+	NatGatewayID    string
+	SnatTableID     string
+	SnatEntryName   string
+	SourceCIDR      string
+	SourceVSwitchID string
+	SnatIP          string
+	Status          string
+}
+
+// DNAT 条目数据结构
+type DnatEntryRecord struct {
+	ForwardEntryID   string
+	NatGatewayID     string
+	ForwardTableID   string
+	ForwardEntryName string
+	ExternalIP       string
+	ExternalPort     string
+	InternalIP       string
+	InternalPort     string
+	IPProtocol       string
+	Status           string
+}
+
+// SaveVPCRecords 覆盖保存指定账户、区域下的全部 VPC，已删除的 VPC 会从表中删除
+func SaveVPCRecords(cloudName string, regionID string, records []VPCRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM vpcs WHERE cloud_name = ? AND region_id = ?", cloudName, regionID); err != nil {
+		return fmt.Errorf("清理 VPC 记录失败 (账户=%s, 区域=%s): %w", cloudName, regionID, err)
+	}
+	for _, rec := range records {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO vpcs
+             (vpc_id, cloud_name, vpc_name, region_id, cidr_block, secondary_cidr_blocks, ipv6_cidr_block, status, is_default, description)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			rec.VPCID, rec.CloudName, rec.VPCName, rec.RegionID, rec.CidrBlock, rec.SecondaryCidrBlocks, rec.Ipv6CidrBlock,
+			rec.Status, rec.IsDefault, rec.Description,
+		)
+		if err != nil {
+			return fmt.Errorf("插入 VPC 记录失败 (VpcID=%s): %w", rec.VPCID, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// SaveVSwitchRecords 覆盖保存指定账户、区域下的全部交换机，已删除的交换机会从表中删除
+func SaveVSwitchRecords(cloudName string, regionID string, records []VSwitchRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM vswitches WHERE cloud_name = ? AND region_id = ?", cloudName, regionID); err != nil {
+		return fmt.Errorf("清理交换机记录失败 (账户=%s, 区域=%s): %w", cloudName, regionID, err)
+	}
+	for _, rec := range records {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO vswitches
+             (vswitch_id, cloud_name, vswitch_name, vpc_id, region_id, zone_id, cidr_block, available_ip_count, status, description)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			rec.VSwitchID, rec.CloudName, rec.VSwitchName, rec.VPCID, rec.RegionID, rec.ZoneID, rec.CidrBlock,
+			rec.AvailableIPCount, rec.Status, rec.Description,
+		)
+		if err != nil {
+			return fmt.Errorf("插入交换机记录失败 (VSwitchID=%s): %w", rec.VSwitchID, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// SaveRouteTableRecords 覆盖保存指定账户、区域下的全部路由表，已删除的路由表及其路由条目会从表中删除
+func SaveRouteTableRecords(cloudName string, regionID string, records []RouteTableRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM route_tables WHERE cloud_name = ? AND region_id = ?", cloudName, regionID); err != nil {
+		return fmt.Errorf("清理路由表记录失败 (账户=%s, 区域=%s): %w", cloudName, regionID, err)
+	}
+	for _, rec := range records {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO route_tables
+             (route_table_id, cloud_name, route_table_name, vpc_id, region_id, route_table_type, vswitch_ids, description)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+			rec.RouteTableID, rec.CloudName, rec.RouteTableName, rec.VPCID, rec.RegionID, rec.RouteTableType, rec.VSwitchIDs, rec.Description,
+		)
+		if err != nil {
+			return fmt.Errorf("插入路由表记录失败 (RouteTableID=%s): %w", rec.RouteTableID, err)
+		}
+	}
+	// 已删除路由表的路由条目不会再被同步覆盖，需一并清理
+	if err := deleteOrphans(tx, "SELECT route_table_id FROM route_tables", "route_table_id", "route_entries"); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// SaveRouteEntries 覆盖保存指定路由表的全部路由条目
+func SaveRouteEntries(routeTableID string, entries []RouteEntryRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM route_entries WHERE route_table_id = ?", routeTableID); err != nil {
+		return fmt.Errorf("清理路由条目失败 (RouteTableID=%s): %w", routeTableID, err)
+	}
+	for _, rec := range entries {
+		_, err := tx.Exec(
+			`INSERT INTO route_entries
+             (route_table_id, route_entry_id, route_entry_name, destination_cidr_block, next_hop_type, next_hop_id, entry_type, status, description)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			routeTableID, rec.RouteEntryID, rec.RouteEntryName, rec.DestinationCidrBlock, rec.NextHopType, rec.NextHopID,
+			rec.EntryType, rec.Status, rec.Description,
+		)
+		if err != nil {
+			return fmt.Errorf("插入路由条目失败 (RouteTableID=%s): %w", routeTableID, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// SaveNatGatewayRecords 覆盖保存指定账户、区域下的全部 NAT 网关，已释放的网关及其 SNAT/DNAT 条目会从表中删除
+func SaveNatGatewayRecords(cloudName string, regionID string, records []NatGatewayRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM nat_gateways WHERE cloud_name = ? AND region_id = ?", cloudName, regionID); err != nil {
+		return fmt.Errorf("清理 NAT 网关记录失败 (账户=%s, 区域=%s): %w", cloudName, regionID, err)
+	}
+	for _, rec := range records {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO nat_gateways
+             (nat_gateway_id, cloud_name, nat_gateway_name, vpc_id, region_id, nat_type, network_type, spec, status, ip_addresses, description)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			rec.NatGatewayID, rec.CloudName, rec.NatGatewayName, rec.VPCID, rec.RegionID, rec.NatType, rec.NetworkType,
+			rec.Spec, rec.Status, rec.IPAddresses, rec.Description,
+		)
+		if err != nil {
+			return fmt.Errorf("插入 NAT 网关记录失败 (NatGatewayID=%s): %w", rec.NatGatewayID, err)
+		}
+	}
+	// 已释放网关的 SNAT/DNAT 条目不会再被同步覆盖，需一并清理
+	if err := deleteOrphans(tx, "SELECT nat_gateway_id FROM nat_gateways", "nat_gateway_id", "nat_snat_entries", "nat_dnat_entries"); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// SaveNatEntries 覆盖保存指定 NAT 网关的 SNAT 与 DNAT 条目
+func SaveNatEntries(natGatewayID string, snatEntries []SnatEntryRecord, dnatEntries []DnatEntryRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM nat_snat_entries WHERE nat_gateway_id = ?", natGatewayID); err != nil {
+		return fmt.Errorf("清理 SNAT 条目失败 (NatGatewayID=%s): %w", natGatewayID, err)
+	}
+	if _, err := tx.Exec("DELETE FROM nat_dnat_entries WHERE nat_gateway_id = ?", natGatewayID); err != nil {
+		return fmt.Errorf("清理 DNAT 条目失败 (NatGatewayID=%s): %w", natGatewayID, err)
+	}
+	for _, rec := range snatEntries {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO nat_snat_entries
+             (snat_entry_id, nat_gateway_id, snat_table_id, snat_entry_name, source_cidr, source_vswitch_id, snat_ip, status)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+			rec.SnatEntryID, natGatewayID, rec.SnatTableID, rec.SnatEntryName, rec.SourceCIDR, rec.SourceVSwitchID, rec.SnatIP, rec.Status,
+		)
+		if err != nil {
+			return fmt.Errorf("插入 SNAT 条目失败 (SnatEntryID=%s): %w", rec.SnatEntryID, err)
+		}
+	}
+	for _, rec := range dnatEntries {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO nat_dnat_entries
+             (forward_entry_id, nat_gateway_id, forward_table_id, forward_entry_name, external_ip, external_port, internal_ip, internal_port, ip_protocol, status)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			rec.ForwardEntryID, natGatewayID, rec.ForwardTableID, rec.ForwardEntryName, rec.ExternalIP, rec.ExternalPort,
+			rec.InternalIP, rec.InternalPort, rec.IPProtocol, rec.Status,
+		)
+		if err != nil {
+			return fmt.Errorf("插入 DNAT 条目失败 (ForwardEntryID=%s): %w", rec.ForwardEntryID, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// 查询所有 VPC 记录
+func ListVPCRecords() ([]VPCRecord, error) {
+	rows, err := db.Query(
+		"SELECT vpc_id, cloud_name, vpc_name, region_id, cidr_block, secondary_cidr_blocks, ipv6_cidr_block, status, is_default, description FROM vpcs",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询 VPC 表失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []VPCRecord
+	for rows.Next() {
+		var rec VPCRecord
+		err := rows.Scan(&rec.VPCID, &rec.CloudName, &rec.VPCName, &rec.RegionID, &rec.CidrBlock, &rec.SecondaryCidrBlocks,
+			&rec.Ipv6CidrBlock, &rec.Status, &rec.IsDefault, &rec.Description)
+		if err != nil {
+			return nil, fmt.Errorf("读取 VPC 行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
+
+// 查询所有交换机记录
+func ListVSwitchRecords() ([]VSwitchRecord, error) {
+	rows, err := db.Query(
+		"SELECT vswitch_id, cloud_name, vswitch_name, vpc_id, region_id, zone_id, cidr_block, available_ip_count, status, description FROM vswitches",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询交换机表失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []VSwitchRecord
+	for rows.Next() {
+		var rec VSwitchRecord
+		err := rows.Scan(&rec.VSwitchID, &rec.CloudName, &rec.VSwitchName, &rec.VPCID, &rec.RegionID, &rec.ZoneID,
+			&rec.CidrBlock, &rec.AvailableIPCount, &rec.Status, &rec.Description)
+		if err != nil {
+			return nil, fmt.Errorf("读取交换机行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
+
+// 查询所有路由表记录
+func ListRouteTableRecords() ([]RouteTableRecord, error) {
+	rows, err := db.Query(
+		"SELECT route_table_id, cloud_name, route_table_name, vpc_id, region_id, route_table_type, vswitch_ids, description FROM route_tables",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询路由表失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []RouteTableRecord
+	for rows.Next() {
+		var rec RouteTableRecord
+		err := rows.Scan(&rec.RouteTableID, &rec.CloudName, &rec.RouteTableName, &rec.VPCID, &rec.RegionID,
+			&rec.RouteTableType, &rec.VSwitchIDs, &rec.Description)
+		if err != nil {
+			return nil, fmt.Errorf("读取路由表行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
+
+// 查询指定路由表的路由条目
+func ListRouteEntries(routeTableID string) ([]RouteEntryRecord, error) {
+	rows, err := db.Query(
+		`SELECT route_table_id, route_entry_id, route_entry_name, destination_cidr_block, next_hop_type, next_hop_id, entry_type, status, description
+		 FROM route_entries WHERE route_table_id = ?`,
+		routeTableID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询路由条目失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []RouteEntryRecord
+	for rows.Next() {
+		var rec RouteEntryRecord
+		err := rows.Scan(&rec.RouteTableID, &rec.RouteEntryID, &rec.RouteEntryName, &rec.DestinationCidrBlock,
+			&rec.NextHopType, &rec.NextHopID, &rec.EntryType, &rec.Status, &rec.Description)
+		if err != nil {
+			return nil, fmt.Errorf("读取路由条目行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
+
+// 查询所有 NAT 网关记录
+func ListNatGatewayRecords() ([]NatGatewayRecord, error) {
+	rows, err := db.Query(
+		"SELECT nat_gateway_id, cloud_name, nat_gateway_name, vpc_id, region_id, nat_type, network_type, spec, status, ip_addresses, description FROM nat_gateways",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询 NAT 网关表失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []NatGatewayRecord
+	for rows.Next() {
+		var rec NatGatewayRecord
+		err := rows.Scan(&rec.NatGatewayID, &rec.CloudName, &rec.NatGatewayName, &rec.VPCID, &rec.RegionID, &rec.NatType,
+			&rec.NetworkType, &rec.Spec, &rec.Status, &rec.IPAddresses, &rec.Description)
+		if err != nil {
+			return nil, fmt.Errorf("读取 NAT 网关行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
+
+// 查询指定 NAT 网关的 SNAT 条目
+func ListSnatEntries(natGatewayID string) ([]SnatEntryRecord, error) {
+	rows, err := db.Query(
+		`SELECT snat_entry_id, nat_gateway_id, snat_table_id, snat_entry_name, source_cidr, source_vswitch_id, snat_ip, status
+		 FROM nat_snat_entries WHERE nat_gateway_id = ?`,
+		natGatewayID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询 SNAT 条目失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []SnatEntryRecord
+	for rows.Next() {
+		var rec SnatEntryRecord
+		err := rows.Scan(&rec.SnatEntryID, &rec.NatGatewayID, &rec.SnatTableID, &rec.SnatEntryName, &rec.SourceCIDR,
+			&rec.SourceVSwitchID, &rec.SnatIP, &rec.Status)
+		if err != nil {
+			return nil, fmt.Errorf("读取 SNAT 条目行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
+
+// 查询指定 NAT 网关的 DNAT 条目
+func ListDnatEntries(natGatewayID string) ([]DnatEntryRecord, error) {
+	rows, err := db.Query(
+		`SELECT forward_entry_id, nat_gateway_id, forward_table_id, forward_entry_name, external_ip, external_port,
+		        internal_ip, internal_port, ip_protocol, status
+		 FROM nat_dnat_entries WHERE nat_gateway_id = ?`,
+		natGatewayID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询 DNAT 条目失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []DnatEntryRecord
+	for rows.Next() {
+		var rec DnatEntryRecord
+		err := rows.Scan(&rec.ForwardEntryID, &rec.NatGatewayID, &rec.ForwardTableID, &rec.ForwardEntryName, &rec.ExternalIP,
+			&rec.ExternalPort, &rec.InternalIP, &rec.InternalPort, &rec.IPProtocol, &rec.Status)
+		if err != nil {
+			return nil, fmt.Errorf("读取 DNAT 条目行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
@@ -0,0 +1,103 @@
+package database
+
+import (
+	"fmt"
+)
+
+// OSS Bucket 表
+func initOSSTables() error {
+	_, err := db.Exec(`CREATE TABLE IF NOT EXISTS oss_buckets (
+		bucket_name TEXT PRIMARY KEY,
+		cloud_name TEXT,
+		region_id TEXT,
+		storage_class TEXT,
+		redundancy_type TEXT,
+		acl TEXT,
+		block_public_access INTEGER,
+		policy_public INTEGER,
+		versioning TEXT,
+		encryption TEXT,
+		extranet_endpoint TEXT,
+		intranet_endpoint TEXT,
+		storage_size INTEGER,
+		object_count INTEGER,
+		create_time TEXT
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 oss_buckets 表失败: %w", err)
+	}
+	return nil
+}
+
+// OSS Bucket 数据结构
+type OSSBucketRecord struct {
+	BucketName        string // Bucket 名称（全局唯一）
+	CloudName         string // 账户名称
+	RegionID          string // 区域ID
+	StorageClass      string // 存储类型（Standard/IA/Archive/ColdArchive）
+	RedundancyType    string // 冗余类型（LRS/ZRS）
+	ACL               string // 读写权限（private/public-read/public-read-write）
+	BlockPublicAccess bool   // 是否开启阻止公共访问
+	PolicyPublic      bool   // Bucket Policy 是否允许公共访问
+	Versioning        string // 版本控制状态（Enabled/Suspended，未开启为空）
+	Encryption        string // 服务端加密方式（AES256/KMS/SM4，未开启为空）
+	ExtranetEndpoint  string // 外网访问域名
+	IntranetEndpoint  string // 内网访问域名
+	StorageSize       int64  // 存储量（字节），无权限获取时为 0
+	ObjectCount       int64  // 文件数量，无权限获取时为 0
+	CreateTime        string // 创建时间
+}
+
+// SaveOSSBucketRecords 覆盖保存指定账户、区域下的全部 OSS Bucket，已删除的 Bucket 会从表中删除
+func SaveOSSBucketRecords(cloudName string, regionID string, records []OSSBucketRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM oss_buckets WHERE cloud_name = ? AND region_id = ?", cloudName, regionID); err != nil {
+		return fmt.Errorf("清理 OSS Bucket 记录失败 (账户=%s, 区域=%s): %w", cloudName, regionID, err)
+	}
+	for _, rec := range records {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO oss_buckets
+             (bucket_name, cloud_name, region_id, storage_class, redundancy_type, acl, block_public_access, policy_public, versioning,
+              encryption, extranet_endpoint, intranet_endpoint, storage_size, object_count, create_time)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			rec.BucketName, rec.CloudName, rec.RegionID, rec.StorageClass, rec.RedundancyType, rec.ACL, rec.BlockPublicAccess,
+			rec.PolicyPublic, rec.Versioning, rec.Encryption, rec.ExtranetEndpoint, rec.IntranetEndpoint, rec.StorageSize,
+			rec.ObjectCount, rec.CreateTime,
+		)
+		if err != nil {
+			return fmt.Errorf("插入 OSS Bucket 记录失败 (BucketName=%s): %w", rec.BucketName, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// 查询所有 OSS Bucket 记录
+func ListOSSBucketRecords() ([]OSSBucketRecord, error) {
+	rows, err := db.Query(
+		`SELECT bucket_name, cloud_name, region_id, storage_class, redundancy_type, acl, block_public_access, policy_public, versioning,
+		        encryption, extranet_endpoint, intranet_endpoint, storage_size, object_count, create_time
+		 FROM oss_buckets`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询 OSS Bucket 表失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []OSSBucketRecord
+	for rows.Next() {
+		var rec OSSBucketRecord
+		err := rows.Scan(&rec.BucketName, &rec.CloudName, &rec.RegionID, &rec.StorageClass, &rec.RedundancyType, &rec.ACL,
+			&rec.BlockPublicAccess, &rec.PolicyPublic, &rec.Versioning, &rec.Encryption, &rec.ExtranetEndpoint, &rec.IntranetEndpoint,
+			&rec.StorageSize, &rec.ObjectCount, &rec.CreateTime)
+		if err != nil {
+			return nil, fmt.Errorf("读取 OSS Bucket 行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
@@ -0,0 +1,153 @@
+package database
+
+import (
+	"fmt"
+)
+
+// PolarDB 集群节点及连接地址表
+func initPolarDBDetailTables() error {
+	// 节点表
+	_, err := db.Exec(`CREATE TABLE IF NOT EXISTS polardb_nodes (
+		node_id TEXT PRIMARY KEY,
+		cluster_id TEXT,
+		node_role TEXT,
+		node_class TEXT,
+		zone_id TEXT,
+		status TEXT
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 polardb_nodes 表失败: %w", err)
+	}
+	// 连接地址表，一个 Endpoint 可能同时有私网和公网地址，每个地址一行
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS polardb_endpoints (
+		cluster_id TEXT,
+		endpoint_id TEXT,
+		endpoint_type TEXT,
+		read_write_mode TEXT,
+		net_type TEXT,
+		connection_string TEXT,
+		port TEXT,
+		ip_address TEXT,
+		vpc_id TEXT,
+		vswitch_id TEXT,
+		description TEXT
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 polardb_endpoints 表失败: %w", err)
+	}
+	_, err = db.Exec(`CREATE INDEX IF NOT EXISTS idx_polardb_endpoints_cluster ON polardb_endpoints (cluster_id);`)
+	if err != nil {
+		return fmt.Errorf("创建 polardb_endpoints 索引失败: %w", err)
+	}
+	return nil
+}
+
+// PolarDB 节点数据结构
+type PolarDBNodeRecord struct {
+	NodeID    string // 节点ID
+	ClusterID string // 集群ID
+	NodeRole  string // 节点角色（Writer/Reader）
+	NodeClass string // 节点规格
+	ZoneID    string // 可用区
+	Status    string // 状态
+}
+
+// PolarDB 连接地址数据结构
+type PolarDBEndpointRecord struct {
+	ClusterID        string // 集群ID
+	EndpointID       string // 连接地址ID
+	EndpointType     string // 地址类型（Cluster/Primary/Custom）
+	ReadWriteMode    string // 读写模式（ReadWrite/ReadOnly）
+	NetType          string // 网络类型（Private/Public）
+	ConnectionString string // 连接串
+	Port             string // 端口
+	IPAddress        string // IP 地址
+	VPCID            string // 专有网络ID
+	VSwitchID        string // 交换机ID
+	Description      string // 描述
+}
+
+// SavePolarDBDetails 覆盖保存指定 PolarDB 集群的节点及连接地址
+func SavePolarDBDetails(clusterID string, nodes []PolarDBNodeRecord, endpoints []PolarDBEndpointRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM polardb_nodes WHERE cluster_id = ?", clusterID); err != nil {
+		return fmt.Errorf("清理 PolarDB 节点失败 (DBClusterID=%s): %w", clusterID, err)
+	}
+	if _, err := tx.Exec("DELETE FROM polardb_endpoints WHERE cluster_id = ?", clusterID); err != nil {
+		return fmt.Errorf("清理 PolarDB 连接地址失败 (DBClusterID=%s): %w", clusterID, err)
+	}
+	for _, rec := range nodes {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO polardb_nodes (node_id, cluster_id, node_role, node_class, zone_id, status)
+             VALUES (?, ?, ?, ?, ?, ?)`,
+			rec.NodeID, clusterID, rec.NodeRole, rec.NodeClass, rec.ZoneID, rec.Status,
+		)
+		if err != nil {
+			return fmt.Errorf("插入 PolarDB 节点记录失败 (NodeID=%s): %w", rec.NodeID, err)
+		}
+	}
+	for _, rec := range endpoints {
+		_, err := tx.Exec(
+			`INSERT INTO polardb_endpoints
+             (cluster_id, endpoint_id, endpoint_type, read_write_mode, net_type, connection_string, port, ip_address, vpc_id, vswitch_id, description)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			clusterID, rec.EndpointID, rec.EndpointType, rec.ReadWriteMode, rec.NetType, rec.ConnectionString, rec.Port,
+			rec.IPAddress, rec.VPCID, rec.VSwitchID, rec.Description,
+		)
+		if err != nil {
+			return fmt.Errorf("插入 PolarDB 连接地址记录失败 (EndpointID=%s): %w", rec.EndpointID, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// 查询所有 PolarDB 节点记录，按集群ID分组返回
+func ListPolarDBNodes() (map[string][]PolarDBNodeRecord, error) {
+	rows, err := db.Query(
+		`SELECT node_id, cluster_id, node_role, node_class, zone_id, status
+		 FROM polardb_nodes ORDER BY cluster_id, node_role DESC, node_id`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询 PolarDB 节点表失败: %w", err)
+	}
+	defer rows.Close()
+
+	results := map[string][]PolarDBNodeRecord{}
+	for rows.Next() {
+		var rec PolarDBNodeRecord
+		if err := rows.Scan(&rec.NodeID, &rec.ClusterID, &rec.NodeRole, &rec.NodeClass, &rec.ZoneID, &rec.Status); err != nil {
+			return nil, fmt.Errorf("读取 PolarDB 节点行数据失败: %w", err)
+		}
+		results[rec.ClusterID] = append(results[rec.ClusterID], rec)
+	}
+	return results, nil
+}
+
+// 查询所有 PolarDB 连接地址记录，按集群ID分组返回
+func ListPolarDBEndpoints() (map[string][]PolarDBEndpointRecord, error) {
+	rows, err := db.Query(
+		`SELECT cluster_id, endpoint_id, endpoint_type, read_write_mode, net_type, connection_string, port, ip_address, vpc_id, vswitch_id, description
+		 FROM polardb_endpoints ORDER BY cluster_id, endpoint_type, net_type`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询 PolarDB 连接地址表失败: %w", err)
+	}
+	defer rows.Close()
+
+	results := map[string][]PolarDBEndpointRecord{}
+	for rows.Next() {
+		var rec PolarDBEndpointRecord
+		err := rows.Scan(&rec.ClusterID, &rec.EndpointID, &rec.EndpointType, &rec.ReadWriteMode, &rec.NetType, &rec.ConnectionString,
+			&rec.Port, &rec.IPAddress, &rec.VPCID, &rec.VSwitchID, &rec.Description)
+		if err != nil {
+			return nil, fmt.Errorf("读取 PolarDB 连接地址行数据失败: %w", err)
+		}
+		results[rec.ClusterID] = append(results[rec.ClusterID], rec)
+	}
+	return results, nil
+}
@@ -0,0 +1,330 @@
+package database
+
+import (
+	"fmt"
+)
+
+// RAM 用户、用户组、角色、AccessKey 及权限策略授权表
+func initRAMTables() error {
+	// RAM 用户表
+	_, err := db.Exec(`CREATE TABLE IF NOT EXISTS ram_users (
+		user_id TEXT PRIMARY KEY,
+		cloud_name TEXT,
+		user_name TEXT,
+		display_name TEXT,
+		comments TEXT,
+		console_login INTEGER,
+		mfa_enabled INTEGER,
+		group_names TEXT,
+		create_date TEXT,
+		last_login_date TEXT
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 ram_users 表失败: %w", err)
+	}
+	// RAM 用户组表
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS ram_groups (
+		group_id TEXT PRIMARY KEY,
+		cloud_name TEXT,
+		group_name TEXT,
+		comments TEXT,
+		create_date TEXT
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 ram_groups 表失败: %w", err)
+	}
+	// RAM 角色表
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS ram_roles (
+		role_id TEXT PRIMARY KEY,
+		cloud_name TEXT,
+		role_name TEXT,
+		arn TEXT,
+		description TEXT,
+		max_session_duration INTEGER,
+		create_date TEXT
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 ram_roles 表失败: %w", err)
+	}
+	// AccessKey 表（只保存 AccessKeyId，不保存 Secret）
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS ram_access_keys (
+		access_key_id TEXT PRIMARY KEY,
+		cloud_name TEXT,
+		user_name TEXT,
+		status TEXT,
+		create_date TEXT,
+		last_used_date TEXT,
+		last_used_unknown INTEGER
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 ram_access_keys 表失败: %w", err)
+	}
+	if err := ensureColumn("ram_access_keys", "last_used_unknown", "INTEGER"); err != nil {
+		return err
+	}
+	// 权限策略授权表
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS ram_policy_attachments (
+		cloud_name TEXT,
+		principal_type TEXT,
+		principal_name TEXT,
+		policy_name TEXT,
+		policy_type TEXT,
+		attach_date TEXT,
+		PRIMARY KEY (cloud_name, principal_type, principal_name, policy_type, policy_name)
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 ram_policy_attachments 表失败: %w", err)
+	}
+	return nil
+}
+
+// 权限策略授权对象类型
+const (
+	RAMPrincipalUser  = "user"  // RAM 用户
+	RAMPrincipalGroup = "group" // RAM 用户组
+	RAMPrincipalRole  = "role"  // RAM 角色
+)
+
+// RAM 用户数据结构
+type RAMUserRecord struct {
+	UserID        string // 用户ID
+	CloudName     string // 账户名称
+	UserName      string // 用户名
+	DisplayName   string // 显示名称
+	Comments      string // 备注
+	ConsoleLogin  bool   // 是否开启控制台登录
+	MFAEnabled    bool   // 是否绑定虚拟 MFA 设备
+	Groups        string // 所属用户组，多个以逗号分隔
+	CreateDate    string // 创建时间
+	LastLoginDate string // 最后登录控制台时间
+}
+
+// RAM 用户组数据结构
+type RAMGroupRecord struct {
+	GroupID    string // 用户组ID
+	CloudName  string // 账户名称
+	GroupName  string // 用户组名称
+	Comments   string // 备注
+	CreateDate string // 创建时间
+}
+
+// RAM 角色数据结构
+type RAMRoleRecord struct {
+	RoleID             string // 角色ID
+	CloudName          string // 账户名称
+	RoleName           string // 角色名称
+	Arn                string // 角色 ARN
+	Description        string // 描述
+	MaxSessionDuration int64  // 最大会话时间（秒）
+	CreateDate         string // 创建时间
+}
+
+// AccessKey 数据结构（不包含 Secret）
+type RAMAccessKeyRecord struct {
+	AccessKeyID     string // AccessKeyId
+	CloudName       string // 账户名称
+	UserName        string // 所属 RAM 用户
+	Status          string // 状态（Active/Inactive）
+	CreateDate      string // 创建时间
+	LastUsedDate    string // 最后使用时间，从未使用为空
+	LastUsedUnknown bool   // 最后使用时间查询失败，无法判断是否使用过
+}
+
+// 权限策略授权数据结构
+type RAMPolicyAttachmentRecord struct {
+	CloudName     string // 账户名称
+	PrincipalType string // 授权对象类型（user/group/role）
+	PrincipalName string // 授权对象名称
+	PolicyName    string // 策略名称
+	PolicyType    string // 策略类型（System/Custom）
+	AttachDate    string // 授权时间
+}
+
+// SaveRAMDetails 覆盖保存指定账户的 RAM 用户、用户组、角色、AccessKey 及权限策略授权
+func SaveRAMDetails(cloudName string, users []RAMUserRecord, groups []RAMGroupRecord, roles []RAMRoleRecord,
+	accessKeys []RAMAccessKeyRecord, attachments []RAMPolicyAttachmentRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, table := range []string{"ram_users", "ram_groups", "ram_roles", "ram_access_keys", "ram_policy_attachments"} {
+		if _, err := tx.Exec("DELETE FROM "+table+" WHERE cloud_name = ?", cloudName); err != nil {
+			return fmt.Errorf("清理 %s 失败 (账户=%s): %w", table, cloudName, err)
+		}
+	}
+	for _, rec := range users {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO ram_users
+             (user_id, cloud_name, user_name, display_name, comments, console_login, mfa_enabled, group_names, create_date, last_login_date)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			rec.UserID, cloudName, rec.UserName, rec.DisplayName, rec.Comments, rec.ConsoleLogin, rec.MFAEnabled, rec.Groups,
+			rec.CreateDate, rec.LastLoginDate,
+		)
+		if err != nil {
+			return fmt.Errorf("插入 RAM 用户记录失败 (UserName=%s): %w", rec.UserName, err)
+		}
+	}
+	for _, rec := range groups {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO ram_groups (group_id, cloud_name, group_name, comments, create_date)
+             VALUES (?, ?, ?, ?, ?)`,
+			rec.GroupID, cloudName, rec.GroupName, rec.Comments, rec.CreateDate,
+		)
+		if err != nil {
+			return fmt.Errorf("插入 RAM 用户组记录失败 (GroupName=%s): %w", rec.GroupName, err)
+		}
+	}
+	for _, rec := range roles {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO ram_roles (role_id, cloud_name, role_name, arn, description, max_session_duration, create_date)
+             VALUES (?, ?, ?, ?, ?, ?, ?)`,
+			rec.RoleID, cloudName, rec.RoleName, rec.Arn, rec.Description, rec.MaxSessionDuration, rec.CreateDate,
+		)
+		if err != nil {
+			return fmt.Errorf("插入 RAM 角色记录失败 (RoleName=%s): %w", rec.RoleName, err)
+		}
+	}
+	for _, rec := range accessKeys {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO ram_access_keys (access_key_id, cloud_name, user_name, status, create_date, last_used_date, last_used_unknown)
+             VALUES (?, ?, ?, ?, ?, ?, ?)`,
+			rec.AccessKeyID, cloudName, rec.UserName, rec.Status, rec.CreateDate, rec.LastUsedDate, rec.LastUsedUnknown,
+		)
+		if err != nil {
+			return fmt.Errorf("插入 AccessKey 记录失败 (AccessKeyID=%s): %w", rec.AccessKeyID, err)
+		}
+	}
+	for _, rec := range attachments {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO ram_policy_attachments (cloud_name, principal_type, principal_name, policy_name, policy_type, attach_date)
+             VALUES (?, ?, ?, ?, ?, ?)`,
+			cloudName, rec.PrincipalType, rec.PrincipalName, rec.PolicyName, rec.PolicyType, rec.AttachDate,
+		)
+		if err != nil {
+			return fmt.Errorf("插入权限策略授权记录失败 (PrincipalName=%s, PolicyName=%s): %w", rec.PrincipalName, rec.PolicyName, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// 查询所有 RAM 用户记录
+func ListRAMUserRecords() ([]RAMUserRecord, error) {
+	rows, err := db.Query(
+		`SELECT user_id, cloud_name, user_name, display_name, comments, console_login, mfa_enabled, group_names, create_date, last_login_date
+		 FROM ram_users ORDER BY cloud_name, user_name`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询 RAM 用户表失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []RAMUserRecord
+	for rows.Next() {
+		var rec RAMUserRecord
+		err := rows.Scan(&rec.UserID, &rec.CloudName, &rec.UserName, &rec.DisplayName, &rec.Comments, &rec.ConsoleLogin, &rec.MFAEnabled,
+			&rec.Groups, &rec.CreateDate, &rec.LastLoginDate)
+		if err != nil {
+			return nil, fmt.Errorf("读取 RAM 用户行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
+
+// 查询所有 RAM 用户组记录
+func ListRAMGroupRecords() ([]RAMGroupRecord, error) {
+	rows, err := db.Query(
+		`SELECT group_id, cloud_name, group_name, comments, create_date FROM ram_groups ORDER BY cloud_name, group_name`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询 RAM 用户组表失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []RAMGroupRecord
+	for rows.Next() {
+		var rec RAMGroupRecord
+		if err := rows.Scan(&rec.GroupID, &rec.CloudName, &rec.GroupName, &rec.Comments, &rec.CreateDate); err != nil {
+			return nil, fmt.Errorf("读取 RAM 用户组行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
+
+// 查询所有 RAM 角色记录
+func ListRAMRoleRecords() ([]RAMRoleRecord, error) {
+	rows, err := db.Query(
+		`SELECT role_id, cloud_name, role_name, arn, description, max_session_duration, create_date
+		 FROM ram_roles ORDER BY cloud_name, role_name`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询 RAM 角色表失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []RAMRoleRecord
+	for rows.Next() {
+		var rec RAMRoleRecord
+		err := rows.Scan(&rec.RoleID, &rec.CloudName, &rec.RoleName, &rec.Arn, &rec.Description, &rec.MaxSessionDuration, &rec.CreateDate)
+		if err != nil {
+			return nil, fmt.Errorf("读取 RAM 角色行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
+
+// 查询所有 AccessKey 记录
+func ListRAMAccessKeyRecords() ([]RAMAccessKeyRecord, error) {
+	rows, err := db.Query(
+		`SELECT access_key_id, cloud_name, user_name, status, create_date, last_used_date, IFNULL(last_used_unknown, 0)
+		 FROM ram_access_keys ORDER BY cloud_name, user_name`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询 AccessKey 表失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []RAMAccessKeyRecord
+	for rows.Next() {
+		var rec RAMAccessKeyRecord
+		err := rows.Scan(&rec.AccessKeyID, &rec.CloudName, &rec.UserName, &rec.Status, &rec.CreateDate, &rec.LastUsedDate, &rec.LastUsedUnknown)
+		if err != nil {
+			return nil, fmt.Errorf("读取 AccessKey 行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
+
+// 查询所有权限策略授权，按 "账户/对象类型/对象名称" 分组返回
+func ListRAMPolicyAttachments() (map[string][]RAMPolicyAttachmentRecord, error) {
+	rows, err := db.Query(
+		`SELECT cloud_name, principal_type, principal_name, policy_name, policy_type, attach_date
+		 FROM ram_policy_attachments ORDER BY policy_type, policy_name`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询权限策略授权表失败: %w", err)
+	}
+	defer rows.Close()
+
+	results := map[string][]RAMPolicyAttachmentRecord{}
+	for rows.Next() {
+		var rec RAMPolicyAttachmentRecord
+		err := rows.Scan(&rec.CloudName, &rec.PrincipalType, &rec.PrincipalName, &rec.PolicyName, &rec.PolicyType, &rec.AttachDate)
+		if err != nil {
+			return nil, fmt.Errorf("读取权限策略授权行数据失败: %w", err)
+		}
+		key := RAMPrincipalKey(rec.CloudName, rec.PrincipalType, rec.PrincipalName)
+		results[key] = append(results[key], rec)
+	}
+	return results, nil
+}
+
+// RAMPrincipalKey 生成授权对象在 ListRAMPolicyAttachments 结果中的键
+func RAMPrincipalKey(cloudName, principalType, principalName string) string {
+	return cloudName + "/" + principalType + "/" + principalName
+}
@@ -0,0 +1,254 @@
+package database
+
+import (
+	"fmt"
+)
+
+// RDS 实例下的数据库、账号、账号授权及 IP 白名单表
+func initRDSDetailTables() error {
+	// 数据库表
+	_, err := db.Exec(`CREATE TABLE IF NOT EXISTS rds_databases (
+		instance_id TEXT,
+		db_name TEXT,
+		engine TEXT,
+		character_set TEXT,
+		status TEXT,
+		description TEXT,
+		PRIMARY KEY (instance_id, db_name)
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 rds_databases 表失败: %w", err)
+	}
+	_, err = db.Exec(`CREATE INDEX IF NOT EXISTS idx_rds_databases_name ON rds_databases (db_name);`)
+	if err != nil {
+		return fmt.Errorf("创建 rds_databases 索引失败: %w", err)
+	}
+	// 账号表
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS rds_accounts (
+		instance_id TEXT,
+		account_name TEXT,
+		account_type TEXT,
+		status TEXT,
+		description TEXT,
+		PRIMARY KEY (instance_id, account_name)
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 rds_accounts 表失败: %w", err)
+	}
+	// 账号授权表
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS rds_account_privileges (
+		instance_id TEXT,
+		account_name TEXT,
+		db_name TEXT,
+		privilege TEXT,
+		privilege_detail TEXT
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 rds_account_privileges 表失败: %w", err)
+	}
+	// IP 白名单分组表
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS rds_whitelists (
+		instance_id TEXT,
+		group_name TEXT,
+		ip_type TEXT,
+		network_type TEXT,
+		attribute TEXT,
+		ip_list TEXT,
+		PRIMARY KEY (instance_id, group_name)
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 rds_whitelists 表失败: %w", err)
+	}
+	return nil
+}
+
+// RDS 数据库数据结构
+type RDSDatabaseRecord struct {
+	InstanceID   string // 实例ID
+	CloudName    string // 账户名称（查询时从 rds 表关联）
+	DBName       string // 数据库名
+	Engine       string // 引擎
+	CharacterSet string // 字符集
+	Status       string // 状态
+	Description  string // 描述
+}
+
+// RDS 账号数据结构
+type RDSAccountRecord struct {
+	InstanceID  string // 实例ID
+	AccountName string // 账号名
+	AccountType string // 账号类型（Normal/Super）
+	Status      string // 状态
+	Description string // 描述
+}
+
+// RDS 账号授权数据结构
+type RDSAccountPrivilegeRecord struct {
+	InstanceID      string // 实例ID
+	AccountName     string // 账号名
+	DBName          string // 数据库名
+	Privilege       string // 权限（ReadWrite/ReadOnly/DDLOnly/DMLOnly/Custom）
+	PrivilegeDetail string // 权限明细
+}
+
+// RDS IP 白名单分组数据结构
+type RDSWhitelistRecord struct {
+	InstanceID  string // 实例ID
+	GroupName   string // 分组名称
+	IPType      string // IP 类型（IPv4/IPv6）
+	NetworkType string // 网络类型（Classic/VPC/MIX）
+	Attribute   string // 分组属性，hidden 表示系统分组
+	IPList      string // IP 列表，逗号分隔
+}
+
+// SaveRDSDetails 覆盖保存指定 RDS 实例的数据库、账号、授权及白名单
+func SaveRDSDetails(instanceID string, databases []RDSDatabaseRecord, accounts []RDSAccountRecord,
+	privileges []RDSAccountPrivilegeRecord, whitelists []RDSWhitelistRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, table := range []string{"rds_databases", "rds_accounts", "rds_account_privileges", "rds_whitelists"} {
+		if _, err := tx.Exec("DELETE FROM "+table+" WHERE instance_id = ?", instanceID); err != nil {
+			return fmt.Errorf("清理 %s 失败 (InstanceID=%s): %w", table, instanceID, err)
+		}
+	}
+	for _, rec := range databases {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO rds_databases (instance_id, db_name, engine, character_set, status, description)
+             VALUES (?, ?, ?, ?, ?, ?)`,
+			instanceID, rec.DBName, rec.Engine, rec.CharacterSet, rec.Status, rec.Description,
+		)
+		if err != nil {
+			return fmt.Errorf("插入 RDS 数据库记录失败 (InstanceID=%s, DBName=%s): %w", instanceID, rec.DBName, err)
+		}
+	}
+	for _, rec := range accounts {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO rds_accounts (instance_id, account_name, account_type, status, description)
+             VALUES (?, ?, ?, ?, ?)`,
+			instanceID, rec.AccountName, rec.AccountType, rec.Status, rec.Description,
+		)
+		if err != nil {
+			return fmt.Errorf("插入 RDS 账号记录失败 (InstanceID=%s, AccountName=%s): %w", instanceID, rec.AccountName, err)
+		}
+	}
+	for _, rec := range privileges {
+		_, err := tx.Exec(
+			`INSERT INTO rds_account_privileges (instance_id, account_name, db_name, privilege, privilege_detail)
+             VALUES (?, ?, ?, ?, ?)`,
+			instanceID, rec.AccountName, rec.DBName, rec.Privilege, rec.PrivilegeDetail,
+		)
+		if err != nil {
+			return fmt.Errorf("插入 RDS 账号授权记录失败 (InstanceID=%s, AccountName=%s): %w", instanceID, rec.AccountName, err)
+		}
+	}
+	for _, rec := range whitelists {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO rds_whitelists (instance_id, group_name, ip_type, network_type, attribute, ip_list)
+             VALUES (?, ?, ?, ?, ?, ?)`,
+			instanceID, rec.GroupName, rec.IPType, rec.NetworkType, rec.Attribute, rec.IPList,
+		)
+		if err != nil {
+			return fmt.Errorf("插入 RDS 白名单记录失败 (InstanceID=%s, GroupName=%s): %w", instanceID, rec.GroupName, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// 查询所有 RDS 数据库记录，instanceID 为空时返回全部实例的数据库
+func ListRDSDatabases(instanceID string) ([]RDSDatabaseRecord, error) {
+	rows, err := db.Query(
+		`SELECT d.instance_id, IFNULL(r.cloud_name, ''), d.db_name, d.engine, d.character_set, d.status, d.description
+		 FROM rds_databases d LEFT JOIN rds r ON r.instance_id = d.instance_id
+		 WHERE ? = '' OR d.instance_id = ?
+		 ORDER BY d.instance_id, d.db_name`,
+		instanceID, instanceID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询 RDS 数据库表失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []RDSDatabaseRecord
+	for rows.Next() {
+		var rec RDSDatabaseRecord
+		err := rows.Scan(&rec.InstanceID, &rec.CloudName, &rec.DBName, &rec.Engine, &rec.CharacterSet, &rec.Status, &rec.Description)
+		if err != nil {
+			return nil, fmt.Errorf("读取 RDS 数据库行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
+
+// 查询指定 RDS 实例的账号
+func ListRDSAccounts(instanceID string) ([]RDSAccountRecord, error) {
+	rows, err := db.Query(
+		`SELECT instance_id, account_name, account_type, status, description
+		 FROM rds_accounts WHERE instance_id = ? ORDER BY account_name`,
+		instanceID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询 RDS 账号表失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []RDSAccountRecord
+	for rows.Next() {
+		var rec RDSAccountRecord
+		if err := rows.Scan(&rec.InstanceID, &rec.AccountName, &rec.AccountType, &rec.Status, &rec.Description); err != nil {
+			return nil, fmt.Errorf("读取 RDS 账号行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
+
+// 查询指定 RDS 实例的账号授权
+func ListRDSAccountPrivileges(instanceID string) ([]RDSAccountPrivilegeRecord, error) {
+	rows, err := db.Query(
+		`SELECT instance_id, account_name, db_name, privilege, privilege_detail
+		 FROM rds_account_privileges WHERE instance_id = ? ORDER BY account_name, db_name`,
+		instanceID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询 RDS 账号授权表失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []RDSAccountPrivilegeRecord
+	for rows.Next() {
+		var rec RDSAccountPrivilegeRecord
+		if err := rows.Scan(&rec.InstanceID, &rec.AccountName, &rec.DBName, &rec.Privilege, &rec.PrivilegeDetail); err != nil {
+			return nil, fmt.Errorf("读取 RDS 账号授权行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
+
+// 查询指定 RDS 实例的 IP 白名单分组
+func ListRDSWhitelists(instanceID string) ([]RDSWhitelistRecord, error) {
+	rows, err := db.Query(
+		`SELECT instance_id, group_name, ip_type, network_type, attribute, ip_list
+		 FROM rds_whitelists WHERE instance_id = ? ORDER BY group_name`,
+		instanceID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询 RDS 白名单表失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []RDSWhitelistRecord
+	for rows.Next() {
+		var rec RDSWhitelistRecord
+		if err := rows.Scan(&rec.InstanceID, &rec.GroupName, &rec.IPType, &rec.NetworkType, &rec.Attribute, &rec.IPList); err != nil {
+			return nil, fmt.Errorf("读取 RDS 白名单行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
@@ -0,0 +1,89 @@
+package database
+
+import (
+	"fmt"
+)
+
+// Tair Redis 连接地址表
+func initRedisDetailTables() error {
+	_, err := db.Exec(`CREATE TABLE IF NOT EXISTS redis_endpoints (
+		instance_id TEXT,
+		connection_string TEXT,
+		ip_address TEXT,
+		port TEXT,
+		net_type TEXT,
+		ip_type TEXT,
+		vpc_id TEXT,
+		vswitch_id TEXT,
+		expired_time TEXT
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 redis_endpoints 表失败: %w", err)
+	}
+	_, err = db.Exec(`CREATE INDEX IF NOT EXISTS idx_redis_endpoints_instance ON redis_endpoints (instance_id);`)
+	if err != nil {
+		return fmt.Errorf("创建 redis_endpoints 索引失败: %w", err)
+	}
+	return nil
+}
+
+// Tair Redis 连接地址数据结构
+type RedisEndpointRecord struct {
+	InstanceID       string // 实例ID
+	ConnectionString string // 连接地址
+	IPAddress        string // IP 地址
+	Port             string // 端口
+	NetType          string // 网络类型（0 公网、1 经典网络、2 专有网络）
+	IPType           string // IP 类型（Public/Private/Inner 等）
+	VPCID            string // 专有网络ID
+	VSwitchID        string // 交换机ID
+	ExpiredTime      string // 经典网络地址保留到期时间
+}
+
+// SaveRedisEndpoints 覆盖保存指定 Tair Redis 实例的连接地址
+func SaveRedisEndpoints(instanceID string, endpoints []RedisEndpointRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM redis_endpoints WHERE instance_id = ?", instanceID); err != nil {
+		return fmt.Errorf("清理 Tair Redis 连接地址失败 (InstanceID=%s): %w", instanceID, err)
+	}
+	for _, rec := range endpoints {
+		_, err := tx.Exec(
+			`INSERT INTO redis_endpoints (instance_id, connection_string, ip_address, port, net_type, ip_type, vpc_id, vswitch_id, expired_time)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			instanceID, rec.ConnectionString, rec.IPAddress, rec.Port, rec.NetType, rec.IPType, rec.VPCID, rec.VSwitchID, rec.ExpiredTime,
+		)
+		if err != nil {
+			return fmt.Errorf("插入 Tair Redis 连接地址记录失败 (InstanceID=%s): %w", instanceID, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// 查询所有 Tair Redis 连接地址记录，按实例ID分组返回
+func ListRedisEndpoints() (map[string][]RedisEndpointRecord, error) {
+	rows, err := db.Query(
+		`SELECT instance_id, connection_string, ip_address, port, net_type, ip_type, vpc_id, vswitch_id, expired_time
+		 FROM redis_endpoints ORDER BY instance_id, net_type`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询 Tair Redis 连接地址表失败: %w", err)
+	}
+	defer rows.Close()
+
+	results := map[string][]RedisEndpointRecord{}
+	for rows.Next() {
+		var rec RedisEndpointRecord
+		err := rows.Scan(&rec.InstanceID, &rec.ConnectionString, &rec.IPAddress, &rec.Port, &rec.NetType, &rec.IPType,
+			&rec.VPCID, &rec.VSwitchID, &rec.ExpiredTime)
+		if err != nil {
+			return nil, fmt.Errorf("读取 Tair Redis 连接地址行数据失败: %w", err)
+		}
+		results[rec.InstanceID] = append(results[rec.InstanceID], rec)
+	}
+	return results, nil
+}
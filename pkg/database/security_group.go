@@ -0,0 +1,269 @@
+package database
+
+import (
+	"fmt"
+)
+
+// 创建安全组、安全组规则以及 ECS 与安全组关联表（如不存在）
+func initSecurityGroupTables() error {
+	// 安全组信息表
+	_, err := db.Exec(`CREATE TABLE IF NOT EXISTS security_groups (
+		security_group_id TEXT PRIMARY KEY,
+		cloud_name TEXT,
+		security_group_name TEXT,
+		security_group_type TEXT,
+		region_id TEXT,
+		vpc_id TEXT,
+		description TEXT,
+		remarks TEXT
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 security_groups 表失败: %w", err)
+	}
+	// 安全组规则表（入方向与出方向）
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS security_group_rules (
+		security_group_id TEXT,
+		rule_id TEXT,
+		direction TEXT,
+		policy TEXT,
+		priority TEXT,
+		ip_protocol TEXT,
+		port_range TEXT,
+		source_cidr_ip TEXT,
+		source_group_id TEXT,
+		dest_cidr_ip TEXT,
+		dest_group_id TEXT,
+		nic_type TEXT,
+		description TEXT
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 security_group_rules 表失败: %w", err)
+	}
+	_, err = db.Exec(`CREATE INDEX IF NOT EXISTS idx_security_group_rules_group ON security_group_rules (security_group_id);`)
+	if err != nil {
+		return fmt.Errorf("创建 security_group_rules 索引失败: %w", err)
+	}
+	// ECS 实例与安全组的关联表
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS ecs_security_groups (
+		instance_id TEXT,
+		security_group_id TEXT,
+		PRIMARY KEY (instance_id, security_group_id)
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 ecs_security_groups 表失败: %w", err)
+	}
+	return nil
+}
+
+// SecurityGroupRecord 安全组数据结构
+type SecurityGroupRecord struct {
+	SecurityGroupID   string // 安全组ID
+	CloudName         string // 账户名称
+	SecurityGroupName string // 安全组名称
+	SecurityGroupType string // 安全组类型（normal/enterprise）
+	RegionID          string // 区域ID
+	VPCID             string // 所属专有网络ID
+	Description       string // 描述
+}
+
+// SecurityGroupRuleRecord 安全组规则数据结构
+type SecurityGroupRuleRecord struct {
+	SecurityGroupID string // 所属安全组ID
+	RuleID          string // 规则ID
+	Direction       string // 方向（ingress/egress）
+	Policy          string // 授权策略（accept/drop）
+	Priority        string // 优先级
+	IPProtocol      string // 协议（TCP/UDP/ICMP/GRE/ALL）
+	PortRange       string // 端口范围，如 22/22，-1/-1 表示全部端口
+	SourceCidrIP    string // 源地址段（入方向）
+	SourceGroupID   string // 源安全组（入方向）
+	DestCidrIP      string // 目的地址段（出方向）
+	DestGroupID     string // 目的安全组（出方向）
+	NicType         string // 网卡类型（internet/intranet）
+	Description     string // 描述
+}
+
+// ECSSecurityGroupRecord ECS 实例与安全组的关联关系
+type ECSSecurityGroupRecord struct {
+	InstanceID      string
+	SecurityGroupID string
+}
+
+// ExposureRecord 暴露面报表中的一行：带公网 IP 的实例及其放通公网访问的入方向规则
+type ExposureRecord struct {
+	InstanceID      string
+	CloudName       string
+	InstanceName    string
+	RegionID        string
+	PublicIP        string
+	SecurityGroupID string
+	IPProtocol      string
+	PortRange       string
+	SourceCidrIP    string
+	Policy          string // 授权策略（accept/drop）
+	Priority        string
+}
+
+// SaveSecurityGroupRecords 覆盖保存指定账户、区域下的全部安全组，已删除的安全组及其规则会从表中删除
+func SaveSecurityGroupRecords(cloudName string, regionID string, records []SecurityGroupRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM security_groups WHERE cloud_name = ? AND region_id = ?", cloudName, regionID); err != nil {
+		return fmt.Errorf("清理安全组记录失败 (账户=%s, 区域=%s): %w", cloudName, regionID, err)
+	}
+	for _, rec := range records {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO security_groups
+             (security_group_id, cloud_name, security_group_name, security_group_type, region_id, vpc_id, description)
+             VALUES (?, ?, ?, ?, ?, ?, ?)`,
+			rec.SecurityGroupID, rec.CloudName, rec.SecurityGroupName, rec.SecurityGroupType, rec.RegionID, rec.VPCID, rec.Description,
+		)
+		if err != nil {
+			return fmt.Errorf("插入安全组记录失败 (SecurityGroupID=%s): %w", rec.SecurityGroupID, err)
+		}
+	}
+	// 已删除安全组的规则不会再被同步覆盖，需一并清理
+	if err := deleteOrphans(tx, "SELECT security_group_id FROM security_groups", "security_group_id", "security_group_rules"); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// SaveSecurityGroupRules 覆盖保存指定安全组的全部规则（先删除旧规则，避免已删除的规则残留）
+func SaveSecurityGroupRules(securityGroupID string, rules []SecurityGroupRuleRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM security_group_rules WHERE security_group_id = ?", securityGroupID); err != nil {
+		return fmt.Errorf("清理安全组规则失败 (SecurityGroupID=%s): %w", securityGroupID, err)
+	}
+	for _, rule := range rules {
+		_, err := tx.Exec(
+			`INSERT INTO security_group_rules
+             (security_group_id, rule_id, direction, policy, priority, ip_protocol, port_range, source_cidr_ip, source_group_id, dest_cidr_ip, dest_group_id, nic_type, description)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			securityGroupID, rule.RuleID, rule.Direction, rule.Policy, rule.Priority, rule.IPProtocol, rule.PortRange,
+			rule.SourceCidrIP, rule.SourceGroupID, rule.DestCidrIP, rule.DestGroupID, rule.NicType, rule.Description,
+		)
+		if err != nil {
+			return fmt.Errorf("插入安全组规则失败 (SecurityGroupID=%s): %w", securityGroupID, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// SaveECSSecurityGroups 覆盖保存一组 ECS 实例与安全组的关联关系
+func SaveECSSecurityGroups(records []ECSSecurityGroupRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	// 同一实例的安全组可能发生变化，先清理该实例已有的关联
+	cleared := make(map[string]bool)
+	for _, rec := range records {
+		if !cleared[rec.InstanceID] {
+			if _, err := tx.Exec("DELETE FROM ecs_security_groups WHERE instance_id = ?", rec.InstanceID); err != nil {
+				return fmt.Errorf("清理 ECS 安全组关联失败 (InstanceID=%s): %w", rec.InstanceID, err)
+			}
+			cleared[rec.InstanceID] = true
+		}
+		_, err := tx.Exec(
+			"INSERT OR REPLACE INTO ecs_security_groups (instance_id, security_group_id) VALUES (?, ?)",
+			rec.InstanceID, rec.SecurityGroupID,
+		)
+		if err != nil {
+			return fmt.Errorf("插入 ECS 安全组关联失败 (InstanceID=%s): %w", rec.InstanceID, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// 查询所有安全组记录
+func ListSecurityGroupRecords() ([]SecurityGroupRecord, error) {
+	rows, err := db.Query(
+		"SELECT security_group_id, cloud_name, security_group_name, security_group_type, region_id, vpc_id, description FROM security_groups",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询安全组表失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []SecurityGroupRecord
+	for rows.Next() {
+		var rec SecurityGroupRecord
+		err := rows.Scan(&rec.SecurityGroupID, &rec.CloudName, &rec.SecurityGroupName, &rec.SecurityGroupType,
+			&rec.RegionID, &rec.VPCID, &rec.Description)
+		if err != nil {
+			return nil, fmt.Errorf("读取安全组行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
+
+// 查询指定安全组的全部规则
+func ListSecurityGroupRules(securityGroupID string) ([]SecurityGroupRuleRecord, error) {
+	rows, err := db.Query(
+		`SELECT security_group_id, rule_id, direction, policy, priority, ip_protocol, port_range,
+		        source_cidr_ip, source_group_id, dest_cidr_ip, dest_group_id, nic_type, description
+		 FROM security_group_rules WHERE security_group_id = ?`,
+		securityGroupID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询安全组规则失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []SecurityGroupRuleRecord
+	for rows.Next() {
+		var rec SecurityGroupRuleRecord
+		err := rows.Scan(&rec.SecurityGroupID, &rec.RuleID, &rec.Direction, &rec.Policy, &rec.Priority, &rec.IPProtocol,
+			&rec.PortRange, &rec.SourceCidrIP, &rec.SourceGroupID, &rec.DestCidrIP, &rec.DestGroupID, &rec.NicType, &rec.Description)
+		if err != nil {
+			return nil, fmt.Errorf("读取安全组规则行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
+
+// ListExposureRecords 查询带公网 IP 的 ECS 实例上，源地址为 0.0.0.0/0 或 ::/0 的入方向规则（含 accept 与 drop）。
+// 同一实例的规则按 instance_id 相邻返回，端口是否最终放通由调用方按优先级判定。
+func ListExposureRecords() ([]ExposureRecord, error) {
+	rows, err := db.Query(
+		`SELECT e.instance_id, e.cloud_name, e.instance_name, e.region_id, e.public_ip,
+		        r.security_group_id, r.ip_protocol, r.port_range, r.source_cidr_ip, r.policy, r.priority
+		 FROM ecs e
+		 JOIN ecs_security_groups es ON es.instance_id = e.instance_id
+		 JOIN security_group_rules r ON r.security_group_id = es.security_group_id
+		 WHERE e.public_ip IS NOT NULL AND e.public_ip != ''
+		   AND lower(r.direction) = 'ingress'
+		   AND r.source_cidr_ip IN ('0.0.0.0/0', '::/0')
+		 ORDER BY e.cloud_name, e.instance_id`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询暴露面数据失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []ExposureRecord
+	for rows.Next() {
+		var rec ExposureRecord
+		err := rows.Scan(&rec.InstanceID, &rec.CloudName, &rec.InstanceName, &rec.RegionID, &rec.PublicIP,
+			&rec.SecurityGroupID, &rec.IPProtocol, &rec.PortRange, &rec.SourceCidrIP, &rec.Policy, &rec.Priority)
+		if err != nil {
+			return nil, fmt.Errorf("读取暴露面行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
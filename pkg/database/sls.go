@@ -0,0 +1,172 @@
+package database
+
+import (
+	"fmt"
+)
+
+// 日志服务 SLS Project 及 Logstore 表
+func initSLSTables() error {
+	// Project 表
+	_, err := db.Exec(`CREATE TABLE IF NOT EXISTS sls_projects (
+		project_name TEXT PRIMARY KEY,
+		cloud_name TEXT,
+		region_id TEXT,
+		description TEXT,
+		status TEXT,
+		data_redundancy_type TEXT,
+		create_time TEXT,
+		modify_time TEXT
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 sls_projects 表失败: %w", err)
+	}
+	// Logstore 表
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS sls_logstores (
+		project_name TEXT,
+		logstore_name TEXT,
+		ttl INTEGER,
+		hot_ttl INTEGER,
+		shard_count INTEGER,
+		auto_split INTEGER,
+		max_split_shard INTEGER,
+		mode TEXT,
+		telemetry_type TEXT,
+		create_time TEXT,
+		modify_time TEXT,
+		PRIMARY KEY (project_name, logstore_name)
+	);`)
+	if err != nil {
+		return fmt.Errorf("创建 sls_logstores 表失败: %w", err)
+	}
+	return nil
+}
+
+// SLS Project 数据结构
+type SLSProjectRecord struct {
+	ProjectName        string // Project 名称
+	CloudName          string // 账户名称
+	RegionID           string // 区域ID
+	Description        string // 描述
+	Status             string // 状态（Normal/Disable）
+	DataRedundancyType string // 数据冗余类型（LRS/ZRS）
+	CreateTime         string // 创建时间
+	ModifyTime         string // 修改时间
+}
+
+// SLS Logstore 数据结构
+type SLSLogstoreRecord struct {
+	ProjectName   string // 所属 Project
+	LogstoreName  string // Logstore 名称
+	TTL           int64  // 数据保存时间（天），3650 表示永久保存
+	HotTTL        int64  // 热存储时间（天），未开启冷热分层时为 0
+	ShardCount    int64  // Shard 数量
+	AutoSplit     bool   // 是否自动分裂 Shard
+	MaxSplitShard int64  // 自动分裂的最大 Shard 数
+	Mode          string // 类型（standard/query）
+	TelemetryType string // 观测数据类型（空为日志，Metrics 为时序库）
+	CreateTime    string // 创建时间
+	ModifyTime    string // 修改时间
+}
+
+// SaveSLSProjectRecords 覆盖保存指定账户、区域下的全部日志项目，已删除项目的 Logstore 会一并删除
+func SaveSLSProjectRecords(cloudName string, regionID string, records []SLSProjectRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM sls_projects WHERE cloud_name = ? AND region_id = ?", cloudName, regionID); err != nil {
+		return fmt.Errorf("清理 SLS Project 记录失败 (账户=%s, 区域=%s): %w", cloudName, regionID, err)
+	}
+	for _, rec := range records {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO sls_projects
+             (project_name, cloud_name, region_id, description, status, data_redundancy_type, create_time, modify_time)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+			rec.ProjectName, rec.CloudName, rec.RegionID, rec.Description, rec.Status, rec.DataRedundancyType, rec.CreateTime, rec.ModifyTime,
+		)
+		if err != nil {
+			return fmt.Errorf("插入 SLS Project 记录失败 (Project=%s): %w", rec.ProjectName, err)
+		}
+	}
+	// 已删除项目的 Logstore 不会再被同步覆盖，需一并清理
+	if err := deleteOrphans(tx, "SELECT project_name FROM sls_projects", "project_name", "sls_logstores"); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// 查询所有 SLS Project 记录
+func ListSLSProjectRecords() ([]SLSProjectRecord, error) {
+	rows, err := db.Query(
+		`SELECT project_name, cloud_name, region_id, description, status, data_redundancy_type, create_time, modify_time
+		 FROM sls_projects`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询 SLS Project 表失败: %w", err)
+	}
+	defer rows.Close()
+
+	var results []SLSProjectRecord
+	for rows.Next() {
+		var rec SLSProjectRecord
+		err := rows.Scan(&rec.ProjectName, &rec.CloudName, &rec.RegionID, &rec.Description, &rec.Status, &rec.DataRedundancyType,
+			&rec.CreateTime, &rec.ModifyTime)
+		if err != nil {
+			return nil, fmt.Errorf("读取 SLS Project 行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
+
+// SaveSLSLogstores 覆盖保存指定 Project 下的 Logstore
+func SaveSLSLogstores(projectName string, records []SLSLogstoreRecord) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM sls_logstores WHERE project_name = ?", projectName); err != nil {
+		return fmt.Errorf("清理 Logstore 失败 (Project=%s): %w", projectName, err)
+	}
+	for _, rec := range records {
+		_, err := tx.Exec(
+			`INSERT OR REPLACE INTO sls_logstores
+             (project_name, logstore_name, ttl, hot_ttl, shard_count, auto_split, max_split_shard, mode, telemetry_type, create_time, modify_time)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			projectName, rec.LogstoreName, rec.TTL, rec.HotTTL, rec.ShardCount, rec.AutoSplit, rec.MaxSplitShard, rec.Mode,
+			rec.TelemetryType, rec.CreateTime, rec.ModifyTime,
+		)
+		if err != nil {
+			return fmt.Errorf("插入 Logstore 记录失败 (Logstore=%s): %w", rec.LogstoreName, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// 查询所有 Logstore，按 Project 分组返回
+func ListSLSLogstores() (map[string][]SLSLogstoreRecord, error) {
+	rows, err := db.Query(
+		`SELECT project_name, logstore_name, ttl, hot_ttl, shard_count, auto_split, max_split_shard, mode, telemetry_type, create_time, modify_time
+		 FROM sls_logstores ORDER BY logstore_name`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("查询 Logstore 表失败: %w", err)
+	}
+	defer rows.Close()
+
+	results := map[string][]SLSLogstoreRecord{}
+	for rows.Next() {
+		var rec SLSLogstoreRecord
+		err := rows.Scan(&rec.ProjectName, &rec.LogstoreName, &rec.TTL, &rec.HotTTL, &rec.ShardCount, &rec.AutoSplit, &rec.MaxSplitShard,
+			&rec.Mode, &rec.TelemetryType, &rec.CreateTime, &rec.ModifyTime)
+		if err != nil {
+			return nil, fmt.Errorf("读取 Logstore 行数据失败: %w", err)
+		}
+		results[rec.ProjectName] = append(results[rec.ProjectName], rec)
+	}
+	return results, nil
+}
@@ -0,0 +1,252 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+// 参与统计及快照的资源表：资源类型、状态、vCPU、内存（MiB）及存储（GB）均为 SQL 表达式，不适用的字段为 0 或空串
+// ecs 表未采集云盘容量，ECS 的存储不计入存储合计
+var statTables = []struct {
+	typeExpr    string
+	table       string
+	idColumn    string // 资源ID字段
+	nameColumn  string // 资源名称字段
+	statusExpr  string
+	cpuExpr     string
+	memoryExpr  string
+	storageExpr string
+}{
+	{"'ecs'", "ecs", "instance_id", "instance_name", "status", "cpu", "memory", "0"},
+	{"'rds'", "rds", "instance_id", "instance_description", "status", "0", "memory", "storage_size"},
+	{"'slb'", "slb", "lb_id", "lb_name", "lb_status", "0", "0", "0"},
+	{"lb_type", "load_balancers", "lb_id", "lb_name", "lb_status", "0", "0", "0"},
+	{"'redis'", "redis", "instance_id", "instance_name", "''", "0", "capacity", "0"},
+	{"'polardb'", "polardb", "dbcluster_id", "dbcluster_description", "db_cluster_status", "0", "memory_size", "0"},
+	{"'mongodb'", "mongodb", "instance_id", "description", "status", "0", "0", "storage_size"},
+	{"product", "analytic_instances", "instance_id", "description", "status", "0", "0", "storage_size"},
+	{"mq_type", "mq_instances", "instance_id", "instance_name", "status", "0", "0", "0"},
+	{"'ack'", "ack_clusters", "cluster_id", "name", "state", "0", "0", "0"},
+	{"'eci'", "eci_container_groups", "container_group_id", "name", "status", "cpu", "memory * 1024", "0"},
+	{"'ess'", "ess_scaling_groups", "scaling_group_id", "name", "status", "0", "0", "0"},
+	{"'fc'", "fc_services", "service_id", "service_name", "''", "0", "0", "0"},
+	{"'sls'", "sls_projects", "project_name", "project_name", "status", "0", "0", "0"},
+	{"'acr'", "acr_instances", "instance_id", "instance_name", "status", "0", "0", "0"},
+	{"'oss'", "oss_buckets", "bucket_name", "bucket_name", "''", "0", "0", "storage_size / 1073741824.0"},
+	{"'vpc'", "vpcs", "vpc_id", "vpc_name", "status", "0", "0", "0"},
+	{"'nat'", "nat_gateways", "nat_gateway_id", "nat_gateway_name", "status", "0", "0", "0"},
+	{"'eip'", "eips", "allocation_id", "eip_name", "status", "0", "0", "0"},
+	{"'vpn'", "vpn_gateways", "vpn_gateway_id", "name", "status", "0", "0", "0"},
+	{"'security_group'", "security_groups", "security_group_id", "security_group_name", "''", "0", "0", "0"},
+}
+
+// 统计筛选条件，为空表示不筛选
+type StatsFilter struct {
+	CloudName    string // 账户名称
+	RegionID     string // 区域ID
+	ResourceType string // 资源类型
+}
+
+// 按账户、区域、资源类型汇总的资源数
+type ResourceCount struct {
+	CloudName    string
+	RegionID     string
+	ResourceType string
+	Count        int64
+}
+
+// 按资源类型、状态汇总的资源数
+type StatusCount struct {
+	ResourceType string
+	Status       string
+	Count        int64
+}
+
+// 按账户汇总的 ECS 容量
+type ECSCapacity struct {
+	CloudName string
+	Instances int64
+	VCPU      int64 // vCPU 合计
+	Memory    int64 // 内存合计（MiB）
+}
+
+// 单一维度的分布
+type Distribution struct {
+	Key   string
+	Count int64
+}
+
+// 数据库引擎及版本分布
+type EngineVersionCount struct {
+	ResourceType  string
+	Engine        string
+	EngineVersion string
+	Count         int64
+}
+
+// resourceUnionSQL 拼接全部资源表的 UNION ALL 子查询，统一输出 resource_type、cloud_name、region_id、status、cpu、memory、storage 七列
+func resourceUnionSQL() string {
+	parts := make([]string, 0, len(statTables))
+	for _, t := range statTables {
+		parts = append(parts, fmt.Sprintf(
+			"SELECT %s AS resource_type, cloud_name, region_id, %s AS status, %s AS cpu, %s AS memory, %s AS storage FROM %s",
+			t.typeExpr, t.statusExpr, t.cpuExpr, t.memoryExpr, t.storageExpr, t.table,
+		))
+	}
+	return "(" + strings.Join(parts, " UNION ALL ") + ")"
+}
+
+// where 根据筛选条件生成 WHERE 子句，columns 指定可用于筛选的字段（账户、区域、资源类型），为空串表示该表不支持此条件
+func (f StatsFilter) where(cloudColumn, regionColumn, typeColumn string) (string, []interface{}) {
+	var conds []string
+	var args []interface{}
+	if f.CloudName != "" && cloudColumn != "" {
+		conds = append(conds, cloudColumn+" = ?")
+		args = append(args, f.CloudName)
+	}
+	if f.RegionID != "" && regionColumn != "" {
+		conds = append(conds, regionColumn+" = ?")
+		args = append(args, f.RegionID)
+	}
+	if f.ResourceType != "" && typeColumn != "" {
+		conds = append(conds, typeColumn+" = ?")
+		args = append(args, f.ResourceType)
+	}
+	if len(conds) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(conds, " AND "), args
+}
+
+// 按账户、区域、资源类型统计资源数
+func CountResources(f StatsFilter) ([]ResourceCount, error) {
+	where, args := f.where("cloud_name", "region_id", "resource_type")
+	rows, err := db.Query(
+		`SELECT cloud_name, region_id, resource_type, COUNT(*) FROM `+resourceUnionSQL()+where+
+			` GROUP BY cloud_name, region_id, resource_type ORDER BY cloud_name, region_id, resource_type`,
+		args...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("统计资源数失败: %w", err)
+	}
+	defer rows.Close()
+
+	results := []ResourceCount{}
+	for rows.Next() {
+		var rec ResourceCount
+		var regionID sql.NullString
+		if err := rows.Scan(&rec.CloudName, &regionID, &rec.ResourceType, &rec.Count); err != nil {
+			return nil, fmt.Errorf("读取资源统计行数据失败: %w", err)
+		}
+		rec.RegionID = regionID.String
+		results = append(results, rec)
+	}
+	return results, nil
+}
+
+// 按资源类型、状态统计资源数
+func CountResourceStatus(f StatsFilter) ([]StatusCount, error) {
+	where, args := f.where("cloud_name", "region_id", "resource_type")
+	rows, err := db.Query(
+		`SELECT resource_type, COALESCE(status, ''), COUNT(*) FROM `+resourceUnionSQL()+where+
+			` GROUP BY resource_type, COALESCE(status, '') ORDER BY resource_type, COUNT(*) DESC`,
+		args...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("统计资源状态失败: %w", err)
+	}
+	defer rows.Close()
+
+	results := []StatusCount{}
+	for rows.Next() {
+		var rec StatusCount
+		if err := rows.Scan(&rec.ResourceType, &rec.Status, &rec.Count); err != nil {
+			return nil, fmt.Errorf("读取资源状态统计行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
+
+// 按账户统计 ECS 实例数、vCPU 及内存合计
+func SumECSCapacity(f StatsFilter) ([]ECSCapacity, error) {
+	where, args := f.where("cloud_name", "region_id", "")
+	rows, err := db.Query(
+		`SELECT cloud_name, COUNT(*), COALESCE(SUM(cpu), 0), COALESCE(SUM(memory), 0) FROM ecs`+where+
+			` GROUP BY cloud_name ORDER BY cloud_name`,
+		args...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("统计 ECS 容量失败: %w", err)
+	}
+	defer rows.Close()
+
+	results := []ECSCapacity{}
+	for rows.Next() {
+		var rec ECSCapacity
+		if err := rows.Scan(&rec.CloudName, &rec.Instances, &rec.VCPU, &rec.Memory); err != nil {
+			return nil, fmt.Errorf("读取 ECS 容量统计行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
+
+// 统计 ECS 指定字段的分布（instance_type、os_name），按数量倒序
+func CountECSDistribution(column string, f StatsFilter) ([]Distribution, error) {
+	switch column {
+	case "instance_type", "os_name":
+	default:
+		return nil, fmt.Errorf("不支持的 ECS 统计字段: %s", column)
+	}
+	where, args := f.where("cloud_name", "region_id", "")
+	rows, err := db.Query(
+		`SELECT COALESCE(`+column+`, ''), COUNT(*) FROM ecs`+where+
+			` GROUP BY COALESCE(`+column+`, '') ORDER BY COUNT(*) DESC, 1`,
+		args...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("统计 ECS %s 分布失败: %w", column, err)
+	}
+	defer rows.Close()
+
+	results := []Distribution{}
+	for rows.Next() {
+		var rec Distribution
+		if err := rows.Scan(&rec.Key, &rec.Count); err != nil {
+			return nil, fmt.Errorf("读取 ECS 分布统计行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}
+
+// 统计 RDS、PolarDB、MongoDB、Redis 及分析型数据库的引擎与版本分布
+func CountDBEngineVersions(f StatsFilter) ([]EngineVersionCount, error) {
+	union := `(SELECT 'rds' AS resource_type, cloud_name, region_id, engine, engine_version FROM rds
+		UNION ALL SELECT 'polardb', cloud_name, region_id, engine, '' FROM polardb
+		UNION ALL SELECT 'mongodb', cloud_name, region_id, 'MongoDB', engine_version FROM mongodb
+		UNION ALL SELECT 'redis', cloud_name, region_id, 'Redis', engine_version FROM redis
+		UNION ALL SELECT product, cloud_name, region_id, engine, engine_version FROM analytic_instances)`
+	where, args := f.where("cloud_name", "region_id", "resource_type")
+	rows, err := db.Query(
+		`SELECT resource_type, COALESCE(engine, ''), COALESCE(engine_version, ''), COUNT(*) FROM `+union+where+
+			` GROUP BY 1, 2, 3 ORDER BY 1, 2, 3`,
+		args...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("统计数据库引擎版本失败: %w", err)
+	}
+	defer rows.Close()
+
+	results := []EngineVersionCount{}
+	for rows.Next() {
+		var rec EngineVersionCount
+		if err := rows.Scan(&rec.ResourceType, &rec.Engine, &rec.EngineVersion, &rec.Count); err != nil {
+			return nil, fmt.Errorf("读取数据库引擎版本统计行数据失败: %w", err)
+		}
+		results = append(results, rec)
+	}
+	return results, nil
+}